@@ -81,6 +81,7 @@ type ApplicationStatus struct {
 	Devfile string `json:"devfile,omitempty"`
 }
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -56,7 +58,35 @@ type GitSource struct {
 	DockerfileURL string `json:"dockerfileUrl,omitempty"`
 }
 
+// ImageSource describes an existing container image to create the component from.
+type ImageSource struct {
+	// The container image reference to create the component from.
+	// Example: quay.io/someorg/somerepository:latest.
+	// Required.
+	// +required
+	ContainerImage string `json:"containerImage"`
+
+	// The digest of the container image. If specified, the image is pinned to this digest rather than the tag.
+	// Example: sha256:1b2dd8b2d2d8b5e1ab8a3e7f0ee0c5b1dd3d31ac3b1b6c16b2d0c5ae1c1a8c4d.
+	// Optional.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// The name of a Kubernetes image pull secret, in the same namespace, used to pull the container image.
+	// Optional.
+	// +optional
+	PullSecret string `json:"pullSecret,omitempty"`
+
+	// The list of platforms the container image is available for.
+	// Example: linux/amd64.
+	// Optional.
+	// +optional
+	Platforms []string `json:"platforms,omitempty"`
+}
+
 // ComponentSource describes the Component source
+// +kubebuilder:validation:MaxProperties=1
 type ComponentSource struct {
 	ComponentSourceUnion `json:",inline"`
 }
@@ -66,6 +96,34 @@ type ComponentSourceUnion struct {
 	// Git Source for a Component.
 	// Optional.
 	GitSource *GitSource `json:"git,omitempty"`
+
+	// Image Source for a Component.
+	// Optional.
+	ImageSource *ImageSource `json:"image,omitempty"`
+}
+
+// SourceType returns the type of the source that is set on the union.
+// An error is returned if either no source, or more than one source, is set.
+func (s *ComponentSourceUnion) SourceType() (ComponentSrcType, error) {
+	var srcType ComponentSrcType
+	set := 0
+	if s.GitSource != nil {
+		srcType = GitComponentSrcType
+		set++
+	}
+	if s.ImageSource != nil {
+		srcType = ImageComponentSrcType
+		set++
+	}
+
+	switch set {
+	case 0:
+		return "", errors.New(MissingGitOrImageSource)
+	case 1:
+		return srcType, nil
+	default:
+		return "", errors.New(MultipleGitAndImageSource)
+	}
 }
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -187,6 +245,7 @@ type GitOpsStatus struct {
 	CommitID string `json:"commitID,omitempty"`
 }
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
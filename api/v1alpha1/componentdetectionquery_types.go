@@ -77,6 +77,7 @@ type ComponentDetectionQueryStatus struct {
 	ComponentDetected ComponentDetectionMap `json:"componentDetected,omitempty"`
 }
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
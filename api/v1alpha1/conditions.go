@@ -0,0 +1,92 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Condition types and reasons used within the status conditions of the appstudio resources. The helpers of the
+// pkg/conditions package may be used to set and query these conditions.
+
+// Constants used with ApplicationStatus's Conditions field
+const (
+	ApplicationConditionCreated = "Created"
+	ApplicationConditionUpdated = "Updated"
+	ApplicationConditionDeleted = "Deleted"
+
+	ApplicationReasonOK    = "OK"
+	ApplicationReasonError = "Error"
+)
+
+// Constants used with ComponentStatus's Conditions field
+const (
+	ComponentConditionCreated = "Created"
+	ComponentConditionUpdated = "Updated"
+	ComponentConditionDeleted = "Deleted"
+
+	ComponentReasonOK    = "OK"
+	ComponentReasonError = "Error"
+)
+
+// Constants used with ComponentDetectionQueryStatus's Conditions field
+const (
+	ComponentDetectionQueryConditionProcessing = "Processing"
+	ComponentDetectionQueryConditionCompleted  = "Completed"
+
+	ComponentDetectionQueryReasonSuccess = "Success"
+	ComponentDetectionQueryReasonFailed  = "Failed"
+)
+
+// Constants used with EnvironmentStatus's Conditions field
+const (
+	EnvironmentConditionReady         = "Ready"
+	EnvironmentConditionErrorOccurred = "ErrorOccurred"
+
+	EnvironmentReasonOK            = "OK"
+	EnvironmentReasonErrorOccurred = "ErrorOccurred"
+)
+
+// Constants used with SnapshotStatus's Conditions field
+const (
+	SnapshotConditionTestSucceeded     = "AppStudioTestSucceeded"
+	SnapshotConditionIntegrationStatus = "AppStudioIntegrationStatus"
+	SnapshotConditionAutoReleased      = "AutoReleased"
+
+	SnapshotReasonPassed     = "Passed"
+	SnapshotReasonFailed     = "Failed"
+	SnapshotReasonInProgress = "InProgress"
+	SnapshotReasonInvalid    = "Invalid"
+)
+
+// Constants used with SnapshotEnvironmentBindingStatus's GitOpsRepoConditions and BindingConditions fields.
+// See the ComponentDeploymentCondition constants for the ComponentDeploymentConditions field.
+const (
+	SnapshotEnvironmentBindingConditionGitOpsResourcesGenerated = "GitOpsResourcesGenerated"
+	SnapshotEnvironmentBindingConditionErrorOccurred            = "ErrorOccurred"
+	SnapshotEnvironmentBindingConditionSnapshotConsistent       = "SnapshotConsistent"
+	SnapshotEnvironmentBindingConditionSuspended                = "Suspended"
+
+	SnapshotEnvironmentBindingReasonSucceeded           = "Succeeded"
+	SnapshotEnvironmentBindingReasonGenerateError       = "GenerateError"
+	SnapshotEnvironmentBindingReasonErrorOccurred       = "ErrorOccurred"
+	SnapshotEnvironmentBindingReasonConsistent          = "Consistent"
+	SnapshotEnvironmentBindingReasonInconsistent        = "Inconsistent"
+	SnapshotEnvironmentBindingReasonSuspended           = "Suspended"
+	SnapshotEnvironmentBindingReasonComponentsSuspended = "ComponentsSuspended"
+	SnapshotEnvironmentBindingReasonResumed             = "Resumed"
+
+	ComponentDeploymentReasonCommitsSynced   = "CommitsSynced"
+	ComponentDeploymentReasonCommitsUnsynced = "CommitsUnsynced"
+	ComponentDeploymentReasonErrorOccurred   = "ErrorOccurred"
+)
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/redhat-appstudio/application-api/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// The Environment, Snapshot and PromotionRun kinds of this version are spokes, which convert to and from the hub
+// version (v1beta1). The ConvertTo and ConvertFrom methods mirror the controller-runtime conversion.Convertible
+// interface with the hub type made concrete, so they can be wrapped by a conversion webhook without this module
+// depending on controller-runtime. The same conversions are registered with the scheme by AddToScheme.
+
+// ConversionDataAnnotation is set on a converted object to preserve the fields that cannot be represented in the
+// version it was converted to, so that converting it back to its original version is lossless.
+const ConversionDataAnnotation = "appstudio.redhat.com/conversion-data"
+
+func init() {
+	SchemeBuilder.SchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds the conversion functions between this version and the hub version to the given scheme.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddConversionFunc((*Environment)(nil), (*v1beta1.Environment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return a.(*Environment).ConvertTo(b.(*v1beta1.Environment))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.Environment)(nil), (*Environment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return b.(*Environment).ConvertFrom(a.(*v1beta1.Environment))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Snapshot)(nil), (*v1beta1.Snapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return a.(*Snapshot).ConvertTo(b.(*v1beta1.Snapshot))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta1.Snapshot)(nil), (*Snapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return b.(*Snapshot).ConvertFrom(a.(*v1beta1.Snapshot))
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*PromotionRun)(nil), (*v1beta1.PromotionRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return a.(*PromotionRun).ConvertTo(b.(*v1beta1.PromotionRun))
+	}); err != nil {
+		return err
+	}
+	return s.AddConversionFunc((*v1beta1.PromotionRun)(nil), (*PromotionRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return b.(*PromotionRun).ConvertFrom(a.(*v1beta1.PromotionRun))
+	})
+}
+
+// convertObjectMeta copies the metadata of src to dst, restoring the conversion data that was preserved on src into
+// restored (if any), and then preserving the fields in preserved (if any) on dst.
+// restored and preserved must be pointers to the conversion data struct of the kind being converted.
+func convertObjectMeta(src metav1.ObjectMeta, dst *metav1.ObjectMeta, restored interface{}, preserved interface{}) error {
+	src.DeepCopyInto(dst)
+
+	if data, exists := dst.Annotations[ConversionDataAnnotation]; exists {
+		if err := json.Unmarshal([]byte(data), restored); err != nil {
+			return fmt.Errorf("unable to unmarshal the %s annotation: %v", ConversionDataAnnotation, err)
+		}
+		delete(dst.Annotations, ConversionDataAnnotation)
+	}
+
+	data, err := json.Marshal(preserved)
+	if err != nil {
+		return fmt.Errorf("unable to marshal the %s annotation: %v", ConversionDataAnnotation, err)
+	}
+	if string(data) != "{}" {
+		if dst.Annotations == nil {
+			dst.Annotations = map[string]string{}
+		}
+		dst.Annotations[ConversionDataAnnotation] = string(data)
+	}
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	return nil
+}
+
+func convertComponentSourceTo(src ComponentSource) v1beta1.ComponentSource {
+	var dst v1beta1.ComponentSource
+	if src.GitSource != nil {
+		gitSource := v1beta1.GitSource(*src.GitSource)
+		dst.GitSource = &gitSource
+	}
+	if src.ImageSource != nil {
+		imageSource := v1beta1.ImageSource(*src.ImageSource.DeepCopy())
+		dst.ImageSource = &imageSource
+	}
+	return dst
+}
+
+func convertComponentSourceFrom(src v1beta1.ComponentSource) ComponentSource {
+	var dst ComponentSource
+	if src.GitSource != nil {
+		gitSource := GitSource(*src.GitSource)
+		dst.GitSource = &gitSource
+	}
+	if src.ImageSource != nil {
+		imageSource := ImageSource(*src.ImageSource.DeepCopy())
+		dst.ImageSource = &imageSource
+	}
+	return dst
+}
+
+func copyConditions(src []metav1.Condition) []metav1.Condition {
+	if src == nil {
+		return nil
+	}
+	dst := make([]metav1.Condition, len(src))
+	for i := range src {
+		src[i].DeepCopyInto(&dst[i])
+	}
+	return dst
+}
+
+// convertRolloutStrategyTo converts a RolloutStrategy to the hub version (v1beta1).
+func convertRolloutStrategyTo(src *RolloutStrategy) *v1beta1.RolloutStrategy {
+	if src == nil {
+		return nil
+	}
+	src = src.DeepCopy()
+	dst := &v1beta1.RolloutStrategy{
+		Type:      v1beta1.RolloutStrategyType(src.Type),
+		Rolling:   (*v1beta1.RollingRolloutStrategy)(src.Rolling),
+		BlueGreen: (*v1beta1.BlueGreenRolloutStrategy)(src.BlueGreen),
+	}
+	if src.Canary != nil {
+		dst.Canary = &v1beta1.CanaryRolloutStrategy{}
+		for _, step := range src.Canary.Steps {
+			dst.Canary.Steps = append(dst.Canary.Steps, v1beta1.CanaryStep{
+				SetWeight: step.SetWeight,
+				Pause:     (*v1beta1.CanaryPause)(step.Pause),
+			})
+		}
+	}
+	return dst
+}
+
+// convertRolloutStrategyFrom converts a RolloutStrategy from the hub version (v1beta1).
+func convertRolloutStrategyFrom(src *v1beta1.RolloutStrategy) *RolloutStrategy {
+	if src == nil {
+		return nil
+	}
+	src = src.DeepCopy()
+	dst := &RolloutStrategy{
+		Type:      RolloutStrategyType(src.Type),
+		Rolling:   (*RollingRolloutStrategy)(src.Rolling),
+		BlueGreen: (*BlueGreenRolloutStrategy)(src.BlueGreen),
+	}
+	if src.Canary != nil {
+		dst.Canary = &CanaryRolloutStrategy{}
+		for _, step := range src.Canary.Steps {
+			dst.Canary.Steps = append(dst.Canary.Steps, CanaryStep{
+				SetWeight: step.SetWeight,
+				Pause:     (*CanaryPause)(step.Pause),
+			})
+		}
+	}
+	return dst
+}
@@ -69,6 +69,7 @@ const (
 	DeploymentTargetPhase_Failed DeploymentTargetPhase = "Failed"
 )
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
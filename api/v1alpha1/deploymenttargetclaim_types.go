@@ -70,6 +70,7 @@ const (
 	AnnBinderValueTrue string = "true"
 )
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
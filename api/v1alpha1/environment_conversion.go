@@ -0,0 +1,130 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/redhat-appstudio/application-api/api/v1beta1"
+)
+
+// environmentConversionData contains the Environment fields which cannot be represented in the other version.
+type environmentConversionData struct {
+	// Type is the deprecated environment type, which was removed in v1beta1
+	Type EnvironmentType `json:"type,omitempty"`
+}
+
+// ConvertTo converts this Environment to the hub version (v1beta1).
+func (src *Environment) ConvertTo(dst *v1beta1.Environment) error {
+	restored := environmentConversionData{}
+	preserved := environmentConversionData{Type: src.Spec.Type}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = v1beta1.EnvironmentSpec{
+		DisplayName:        src.Spec.DisplayName,
+		DeploymentStrategy: v1beta1.DeploymentStrategyType(src.Spec.DeploymentStrategy),
+		ParentEnvironment:  src.Spec.ParentEnvironment,
+		Tags:               append([]string(nil), src.Spec.Tags...),
+		Configuration: v1beta1.EnvironmentConfiguration{
+			Target: v1beta1.EnvironmentTarget{
+				DeploymentTargetClaim: v1beta1.DeploymentTargetClaimConfig(src.Spec.Configuration.Target.DeploymentTargetClaim),
+			},
+			RolloutStrategy: convertRolloutStrategyTo(src.Spec.Configuration.RolloutStrategy),
+		},
+	}
+	for _, env := range src.Spec.Configuration.Env {
+		dst.Spec.Configuration.Env = append(dst.Spec.Configuration.Env, v1beta1.EnvVarPair(env))
+	}
+	dst.Spec.ApprovalPolicy = (*v1beta1.ApprovalPolicy)(src.Spec.ApprovalPolicy.DeepCopy())
+	if schedule := src.Spec.DeploymentSchedule; schedule != nil {
+		dst.Spec.DeploymentSchedule = &v1beta1.DeploymentSchedule{TimeZone: schedule.TimeZone}
+		for _, window := range schedule.Windows {
+			dstWindow := v1beta1.DeploymentWindow{Start: window.Start, End: window.End}
+			for _, day := range window.Days {
+				dstWindow.Days = append(dstWindow.Days, v1beta1.Weekday(day))
+			}
+			dst.Spec.DeploymentSchedule.Windows = append(dst.Spec.DeploymentSchedule.Windows, dstWindow)
+		}
+		for _, blackout := range schedule.Blackouts {
+			dst.Spec.DeploymentSchedule.Blackouts = append(dst.Spec.DeploymentSchedule.Blackouts, v1beta1.BlackoutPeriod(blackout))
+		}
+	}
+	if unstableConfig := src.Spec.UnstableConfigurationFields; unstableConfig != nil {
+		dst.Spec.ClusterConfiguration = &v1beta1.ClusterConfiguration{
+			ClusterType:                  v1beta1.ConfigurationClusterType(unstableConfig.ClusterType),
+			KubernetesClusterCredentials: v1beta1.KubernetesClusterCredentials(*unstableConfig.KubernetesClusterCredentials.DeepCopy()),
+		}
+	}
+
+	dst.Status = v1beta1.EnvironmentStatus{
+		Conditions: copyConditions(src.Status.Conditions),
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this Environment.
+func (dst *Environment) ConvertFrom(src *v1beta1.Environment) error {
+	restored := environmentConversionData{}
+	preserved := environmentConversionData{}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = EnvironmentSpec{
+		Type:               restored.Type,
+		DisplayName:        src.Spec.DisplayName,
+		DeploymentStrategy: DeploymentStrategyType(src.Spec.DeploymentStrategy),
+		ParentEnvironment:  src.Spec.ParentEnvironment,
+		Tags:               append([]string(nil), src.Spec.Tags...),
+		Configuration: EnvironmentConfiguration{
+			Target: EnvironmentTarget{
+				DeploymentTargetClaim: DeploymentTargetClaimConfig(src.Spec.Configuration.Target.DeploymentTargetClaim),
+			},
+			RolloutStrategy: convertRolloutStrategyFrom(src.Spec.Configuration.RolloutStrategy),
+		},
+	}
+	for _, env := range src.Spec.Configuration.Env {
+		dst.Spec.Configuration.Env = append(dst.Spec.Configuration.Env, EnvVarPair(env))
+	}
+	dst.Spec.ApprovalPolicy = (*ApprovalPolicy)(src.Spec.ApprovalPolicy.DeepCopy())
+	if schedule := src.Spec.DeploymentSchedule; schedule != nil {
+		dst.Spec.DeploymentSchedule = &DeploymentSchedule{TimeZone: schedule.TimeZone}
+		for _, window := range schedule.Windows {
+			dstWindow := DeploymentWindow{Start: window.Start, End: window.End}
+			for _, day := range window.Days {
+				dstWindow.Days = append(dstWindow.Days, Weekday(day))
+			}
+			dst.Spec.DeploymentSchedule.Windows = append(dst.Spec.DeploymentSchedule.Windows, dstWindow)
+		}
+		for _, blackout := range schedule.Blackouts {
+			dst.Spec.DeploymentSchedule.Blackouts = append(dst.Spec.DeploymentSchedule.Blackouts, BlackoutPeriod(blackout))
+		}
+	}
+	if clusterConfig := src.Spec.ClusterConfiguration; clusterConfig != nil {
+		dst.Spec.UnstableConfigurationFields = &UnstableEnvironmentConfiguration{
+			ClusterType:                  ConfigurationClusterType(clusterConfig.ClusterType),
+			KubernetesClusterCredentials: KubernetesClusterCredentials(*clusterConfig.KubernetesClusterCredentials.DeepCopy()),
+		}
+	}
+
+	dst.Status = EnvironmentStatus{
+		Conditions: copyConditions(src.Status.Conditions),
+	}
+
+	return nil
+}
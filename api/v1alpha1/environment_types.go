@@ -46,6 +46,18 @@ type EnvironmentSpec struct {
 	// the Environment.
 	Configuration EnvironmentConfiguration `json:"configuration,omitempty"`
 
+	// ApprovalPolicy requires promotions to the Environment to be approved before the Environment is promoted to.
+	// If not set, promotions to the Environment do not require any approval.
+	// Optional.
+	// +optional
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty"`
+
+	// DeploymentSchedule restricts the times at which the Environment may be promoted to, for example to business
+	// hours, and excludes change freeze periods. If not set, the Environment may be promoted to at any time.
+	// Optional.
+	// +optional
+	DeploymentSchedule *DeploymentSchedule `json:"deploymentSchedule,omitempty"`
+
 	// UnstableConfigurationFields are experimental/prototype: the API has not been finalized here, and is subject to breaking changes.
 	// See comment on UnstableEnvironmentConfiguration for details.
 	UnstableConfigurationFields *UnstableEnvironmentConfiguration `json:"unstableConfigurationFields,omitempty"`
@@ -63,6 +75,98 @@ const (
 	EnvironmentType_NonPOC EnvironmentType = "Non-POC"
 )
 
+// ApprovalPolicy defines who must approve a promotion to an Environment. If neither approvers nor approver groups are
+// specified, any user may approve.
+type ApprovalPolicy struct {
+	// Approvers are the names of the users who may approve a promotion.
+	// Optional.
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+
+	// ApproverGroups are the names of the groups whose members may approve a promotion.
+	// Optional.
+	// +optional
+	ApproverGroups []string `json:"approverGroups,omitempty"`
+
+	// RequiredApprovals is the number of approvals, from distinct approvers, that a promotion requires.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+}
+
+// DeploymentSchedule defines when an Environment may be promoted to: within one of its windows (or at any time, if
+// there are none), and outside of all of its blackout periods.
+type DeploymentSchedule struct {
+	// TimeZone is the IANA name of the time zone the windows are expressed in.
+	// Example: Europe/Prague.
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows are the recurring periods during which promotions are allowed. If empty, promotions are allowed at any
+	// time outside of the blackout periods.
+	// Optional.
+	// +optional
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+
+	// Blackouts are the periods during which promotions are not allowed, for example change freezes. Blackouts take
+	// precedence over windows.
+	// Optional.
+	// +optional
+	Blackouts []BlackoutPeriod `json:"blackouts,omitempty"`
+}
+
+// DeploymentWindow is a recurring period of a day during which promotions are allowed, in the time zone of the
+// schedule.
+type DeploymentWindow struct {
+	// Days are the days of the week on which the window starts. If empty, the window starts every day.
+	// Optional.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of the day at which the window starts, in the HH:MM format.
+	// Example: 09:00.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of the day at which the window ends (exclusive), in the HH:MM format. If End is not after
+	// Start, the window ends on the following day.
+	// Example: 17:00.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+const (
+	Weekday_Monday    Weekday = "Mon"
+	Weekday_Tuesday   Weekday = "Tue"
+	Weekday_Wednesday Weekday = "Wed"
+	Weekday_Thursday  Weekday = "Thu"
+	Weekday_Friday    Weekday = "Fri"
+	Weekday_Saturday  Weekday = "Sat"
+	Weekday_Sunday    Weekday = "Sun"
+)
+
+// BlackoutPeriod is a period during which promotions are not allowed.
+type BlackoutPeriod struct {
+	// Name is a user-visible description of the blackout period.
+	// Example: end-of-year freeze.
+	// Optional.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is the time at which the blackout period starts.
+	Start metav1.Time `json:"start"`
+
+	// End is the time at which the blackout period ends (exclusive).
+	End metav1.Time `json:"end"`
+}
+
 // DeploymentStrategyType defines the available promotion/deployment strategies for an Environment
 // See Environment API doc for details.
 type DeploymentStrategyType string
@@ -158,6 +262,12 @@ type EnvironmentConfiguration struct {
 	// The Environment controller uses the referenced DeploymentTargetClaim to access its bounded
 	// DeploymentTarget with cluster credential secret.
 	Target EnvironmentTarget `json:"target,omitempty"`
+
+	// RolloutStrategy describes how new versions of the components are rolled out within the Environment. It can be
+	// overridden per component by the SnapshotEnvironmentBinding.
+	// Optional: the components are rolled out with a rolling update if not set.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
 }
 
 // EnvironmentTarget provides the configuration for a deployment target.
@@ -175,8 +285,10 @@ type EnvironmentStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // Environment is the Schema for the environments API
 // +kubebuilder:resource:path=environments,shortName=env
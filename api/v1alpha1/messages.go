@@ -26,10 +26,58 @@ const (
 
 	MissingIngressDomain = "ingress domain cannot be empty if cluster is of type Kubernetes"
 
-	MissingGitOrImageSource = "a git source or an image source must be specified when creating a component"
+	MissingGitOrImageSource   = "a git source or an image source must be specified when creating a component"
+	MultipleGitAndImageSource = "only one of a git source or an image source may be specified for a component"
 
 	ComponentNameUpdateError   = "component name cannot be updated to %s"
 	ApplicationNameUpdateError = "application name cannot be updated to %s"
 	GitSourceUpdateError       = "git source cannot be updated to %+v"
+	ImageSourceUpdateError     = "image source cannot be updated to %+v"
+	SourceTypeUpdateError      = "component source type cannot be changed from %q to %q"
 	InvalidComponentError      = "runtime object is not of type Component"
+
+	AppModelRepositoryUpdateError = "app model repository cannot be updated to %+v"
+	GitOpsRepositoryUpdateError   = "gitops repository cannot be updated to %+v"
+
+	InvalidRequiredApprovals       = "required approvals cannot be negative; leave it unset to require a single approval"
+	RolloutStrategyMismatch        = "'%s' may only be specified for the %s rollout strategy type"
+	MissingCanarySteps             = "a canary rollout strategy must have at least one step"
+	InvalidCanaryStep              = "exactly one of 'setWeight' or 'pause' must be specified"
+	InvalidCanaryWeight            = "weight must be between 0 and 100"
+	InvalidDeploymentSchedule      = "invalid deployment schedule: %v"
+	TooManyRequiredApprovals       = "required approvals (%d) cannot exceed the number of approvers (%d) when no approver group is specified"
+	ParentEnvironmentSelfReference = "an environment cannot be its own parent environment"
+
+	EnvironmentNameUpdateError = "environment name cannot be updated to %s"
+
+	SnapshotEnvironmentBindingExists     = "snapshot environment binding %q already binds application %q to environment %q"
+	DuplicateSnapshotEnvironmentBindings = "application %q is bound to environment %q of namespace %q by multiple snapshot environment bindings: %v"
+	SnapshotApplicationMismatch          = "snapshot %q belongs to application %q, not to application %q"
+	MissingBindingComponent              = "component %q of snapshot %q is missing from the binding components"
+	UnknownBindingComponent              = "component %q is not part of snapshot %q"
+	BindingComponentApplicationMismatch  = "component %q belongs to application %q, not to application %q"
+
+	InvalidPromotionRunStateTransition     = "promotion run state cannot transition from %q to %q"
+	PromotionRunCompleteError              = "promotion run is complete, its status can no longer be updated"
+	MissingPromotionRunCompletionResult    = "completion result must be set when the promotion run state is 'Complete'"
+	UnexpectedPromotionRunCompletionResult = "completion result can only be set when the promotion run state is 'Complete'"
+	InvalidPromotionRunStep                = "environment status step must be %d, the sequential number of the step starting with 1"
+	PromotionRunStepUpdateError            = "environment status step %d for environment %q cannot be updated once it has completed"
+	PromotionRunStepRemovedError           = "environment status step %d for environment %q cannot be removed"
+	PromotionStartTimeUpdateError          = "promotion start time cannot be updated once it has been set"
+	ApprovalDecisionUpdateError            = "approval decisions of environment %q cannot be removed or changed"
+	InvalidApprovalDecision                = "approval decision %q must be either 'Approved' or 'Rejected'"
+	RollbackStatusUpdateError              = "rollback status cannot be updated once it has been set"
+
+	MissingPromotionTarget        = "either a manual promotion, an automated promotion or a rollback must be specified"
+	MultiplePromotionTargets      = "only one of a manual promotion, an automated promotion or a rollback may be specified"
+	RollbackSnapshotForbidden     = "the snapshot of a rollback must be specified within the rollback, either as a snapshot name or as 'previous'"
+	InvalidPromotionRunDuration   = "duration must be greater than zero"
+	EnvironmentTimeoutTooLong     = "environment timeout cannot exceed the timeout of the whole promotion"
+	InvalidPromotionRunRetries    = "max retries cannot be negative"
+	PromotionRunCancelUpdateError = "a cancelled promotion run cannot be resumed"
+	PromotionRunSpecUpdateError   = "promotion run spec cannot be updated once the promotion run is created, except to cancel it"
+
+	DeploymentTargetClassNameUpdateError = "deployment target class name cannot be updated to %s"
+	TargetNameUpdateError                = "target name cannot be updated to %s once it has been set"
 )
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/redhat-appstudio/application-api/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// promotionRunConversionData contains the PromotionRun fields which cannot be represented in the other version.
+type promotionRunConversionData struct {
+	// ManualPromotion is a manual promotion without a target environment, which is invalid in v1beta1
+	ManualPromotion *ManualPromotionConfiguration `json:"manualPromotion,omitempty"`
+
+	// AutomatedPromotion is an automated promotion without an initial environment, which is invalid in v1beta1
+	AutomatedPromotion *AutomatedPromotionConfiguration `json:"automatedPromotion,omitempty"`
+
+	// ConditionLastProbeTimes are the LastProbeTime of the conditions, which does not exist in v1beta1
+	ConditionLastProbeTimes []conditionLastProbeTime `json:"conditionLastProbeTimes,omitempty"`
+}
+
+// conditionLastProbeTime is the LastProbeTime of the condition at the given index of the conditions. The type of the
+// condition is recorded so that the time is not restored onto another condition if the conditions were reordered.
+type conditionLastProbeTime struct {
+	Index         int         `json:"index"`
+	Type          string      `json:"type"`
+	LastProbeTime metav1.Time `json:"lastProbeTime"`
+}
+
+// ConvertTo converts this PromotionRun to the hub version (v1beta1).
+func (src *PromotionRun) ConvertTo(dst *v1beta1.PromotionRun) error {
+	restored := promotionRunConversionData{}
+	preserved := promotionRunConversionData{}
+	for i, condition := range src.Status.Conditions {
+		if !condition.LastProbeTime.IsZero() {
+			preserved.ConditionLastProbeTimes = append(preserved.ConditionLastProbeTimes, conditionLastProbeTime{
+				Index:         i,
+				Type:          string(condition.Type),
+				LastProbeTime: condition.LastProbeTime,
+			})
+		}
+	}
+	// A promotion without an environment is considered unset in v1alpha1, and is preserved since it is invalid in v1beta1
+	if src.Spec.ManualPromotion != nil && src.Spec.ManualPromotion.TargetEnvironment == "" {
+		preserved.ManualPromotion = src.Spec.ManualPromotion.DeepCopy()
+	}
+	if src.Spec.AutomatedPromotion != nil && src.Spec.AutomatedPromotion.InitialEnvironment == "" {
+		preserved.AutomatedPromotion = src.Spec.AutomatedPromotion.DeepCopy()
+	}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = v1beta1.PromotionRunSpec{
+		Snapshot:    src.Spec.Snapshot,
+		Application: src.Spec.Application,
+	}
+	if src.Spec.ManualPromotion != nil && src.Spec.ManualPromotion.TargetEnvironment != "" {
+		dst.Spec.ManualPromotion = &v1beta1.ManualPromotionConfiguration{TargetEnvironment: src.Spec.ManualPromotion.TargetEnvironment}
+	}
+	if src.Spec.AutomatedPromotion != nil && src.Spec.AutomatedPromotion.InitialEnvironment != "" {
+		dst.Spec.AutomatedPromotion = &v1beta1.AutomatedPromotionConfiguration{InitialEnvironment: src.Spec.AutomatedPromotion.InitialEnvironment}
+	}
+	if src.Spec.Rollback != nil {
+		dst.Spec.Rollback = (*v1beta1.RollbackConfiguration)(src.Spec.Rollback.DeepCopy())
+	}
+	dst.Spec.Timeout = src.Spec.Timeout.DeepCopy()
+	dst.Spec.EnvironmentTimeout = src.Spec.EnvironmentTimeout.DeepCopy()
+	if src.Spec.RetryPolicy != nil {
+		dst.Spec.RetryPolicy = &v1beta1.PromotionRunRetryPolicy{
+			MaxRetries: src.Spec.RetryPolicy.MaxRetries,
+			Backoff:    src.Spec.RetryPolicy.Backoff.DeepCopy(),
+		}
+	}
+	dst.Spec.Cancel = src.Spec.Cancel
+
+	dst.Status = v1beta1.PromotionRunStatus{
+		State:              v1beta1.PromotionRunState(src.Status.State),
+		CompletionResult:   v1beta1.PromotionRunCompleteResult(src.Status.CompletionResult),
+		ActiveBindings:     append([]string(nil), src.Status.ActiveBindings...),
+		PromotionStartTime: src.Status.PromotionStartTime,
+	}
+	if src.Status.Rollback != nil {
+		dst.Status.Rollback = (*v1beta1.PromotionRunRollbackStatus)(src.Status.Rollback.DeepCopy())
+	}
+	for _, approval := range src.Status.Approvals {
+		dstApproval := v1beta1.PromotionRunEnvironmentApproval{
+			EnvironmentName:   approval.EnvironmentName,
+			State:             v1beta1.ApprovalState(approval.State),
+			RequiredApprovals: approval.RequiredApprovals,
+		}
+		for _, decision := range approval.Decisions {
+			dstApproval.Decisions = append(dstApproval.Decisions, v1beta1.ApprovalDecision{
+				Approver: decision.Approver,
+				Decision: v1beta1.ApprovalDecisionType(decision.Decision),
+				Comment:  decision.Comment,
+				Time:     decision.Time,
+			})
+		}
+		dst.Status.Approvals = append(dst.Status.Approvals, dstApproval)
+	}
+	for _, envStatus := range src.Status.EnvironmentStatus {
+		dst.Status.EnvironmentStatus = append(dst.Status.EnvironmentStatus, v1beta1.PromotionRunEnvironmentStatus{
+			Step:            envStatus.Step,
+			EnvironmentName: envStatus.EnvironmentName,
+			Status:          v1beta1.PromotionRunEnvironmentStatusField(envStatus.Status),
+			DisplayStatus:   envStatus.DisplayStatus,
+			StartTime:       envStatus.StartTime.DeepCopy(),
+		})
+	}
+	for _, condition := range src.Status.Conditions {
+		dstCondition := metav1.Condition{
+			Type:               string(condition.Type),
+			Status:             metav1.ConditionStatus(condition.Status),
+			ObservedGeneration: condition.ObservedGeneration,
+			Reason:             string(condition.Reason),
+			Message:            condition.Message,
+		}
+		if condition.LastTransitionTime != nil {
+			dstCondition.LastTransitionTime = *condition.LastTransitionTime
+		}
+		dst.Status.Conditions = append(dst.Status.Conditions, dstCondition)
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this PromotionRun.
+func (dst *PromotionRun) ConvertFrom(src *v1beta1.PromotionRun) error {
+	restored := promotionRunConversionData{}
+	preserved := promotionRunConversionData{}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = PromotionRunSpec{
+		Snapshot:    src.Spec.Snapshot,
+		Application: src.Spec.Application,
+	}
+	if src.Spec.ManualPromotion != nil {
+		dst.Spec.ManualPromotion = &ManualPromotionConfiguration{TargetEnvironment: src.Spec.ManualPromotion.TargetEnvironment}
+	} else {
+		dst.Spec.ManualPromotion = restored.ManualPromotion
+	}
+	if src.Spec.AutomatedPromotion != nil {
+		dst.Spec.AutomatedPromotion = &AutomatedPromotionConfiguration{InitialEnvironment: src.Spec.AutomatedPromotion.InitialEnvironment}
+	} else {
+		dst.Spec.AutomatedPromotion = restored.AutomatedPromotion
+	}
+	if src.Spec.Rollback != nil {
+		dst.Spec.Rollback = (*RollbackConfiguration)(src.Spec.Rollback.DeepCopy())
+	}
+	dst.Spec.Timeout = src.Spec.Timeout.DeepCopy()
+	dst.Spec.EnvironmentTimeout = src.Spec.EnvironmentTimeout.DeepCopy()
+	if src.Spec.RetryPolicy != nil {
+		dst.Spec.RetryPolicy = &PromotionRunRetryPolicy{
+			MaxRetries: src.Spec.RetryPolicy.MaxRetries,
+			Backoff:    src.Spec.RetryPolicy.Backoff.DeepCopy(),
+		}
+	}
+	dst.Spec.Cancel = src.Spec.Cancel
+
+	dst.Status = PromotionRunStatus{
+		State:              PromotionRunState(src.Status.State),
+		CompletionResult:   PromotionRunCompleteResult(src.Status.CompletionResult),
+		ActiveBindings:     append([]string(nil), src.Status.ActiveBindings...),
+		PromotionStartTime: src.Status.PromotionStartTime,
+	}
+	if src.Status.Rollback != nil {
+		dst.Status.Rollback = (*PromotionRunRollbackStatus)(src.Status.Rollback.DeepCopy())
+	}
+	for _, approval := range src.Status.Approvals {
+		dstApproval := PromotionRunEnvironmentApproval{
+			EnvironmentName:   approval.EnvironmentName,
+			State:             ApprovalState(approval.State),
+			RequiredApprovals: approval.RequiredApprovals,
+		}
+		for _, decision := range approval.Decisions {
+			dstApproval.Decisions = append(dstApproval.Decisions, ApprovalDecision{
+				Approver: decision.Approver,
+				Decision: ApprovalDecisionType(decision.Decision),
+				Comment:  decision.Comment,
+				Time:     decision.Time,
+			})
+		}
+		dst.Status.Approvals = append(dst.Status.Approvals, dstApproval)
+	}
+	for _, envStatus := range src.Status.EnvironmentStatus {
+		dst.Status.EnvironmentStatus = append(dst.Status.EnvironmentStatus, PromotionRunEnvironmentStatus{
+			Step:            envStatus.Step,
+			EnvironmentName: envStatus.EnvironmentName,
+			Status:          PromotionRunEnvironmentStatusField(envStatus.Status),
+			DisplayStatus:   envStatus.DisplayStatus,
+			StartTime:       envStatus.StartTime.DeepCopy(),
+		})
+	}
+	lastProbeTimes := map[int]conditionLastProbeTime{}
+	for _, probeTime := range restored.ConditionLastProbeTimes {
+		lastProbeTimes[probeTime.Index] = probeTime
+	}
+	for i, condition := range src.Status.Conditions {
+		dstCondition := PromotionRunCondition{
+			Type:               PromotionRunConditionType(condition.Type),
+			Message:            condition.Message,
+			Status:             PromotionRunConditionStatus(condition.Status),
+			Reason:             PromotionRunReasonType(condition.Reason),
+			ObservedGeneration: condition.ObservedGeneration,
+		}
+		if probeTime, exists := lastProbeTimes[i]; exists && probeTime.Type == condition.Type {
+			dstCondition.LastProbeTime = probeTime.LastProbeTime
+		}
+		if !condition.LastTransitionTime.IsZero() {
+			lastTransitionTime := condition.LastTransitionTime
+			dstCondition.LastTransitionTime = &lastTransitionTime
+		}
+		dst.Status.Conditions = append(dst.Status.Conditions, dstCondition)
+	}
+
+	return nil
+}
@@ -17,25 +17,109 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PromotionRunSpec defines the desired state of PromotionRun
+// +kubebuilder:validation:XValidation:rule="[has(self.manualPromotion) && size(self.manualPromotion.targetEnvironment) > 0, has(self.automatedPromotion) && size(self.automatedPromotion.initialEnvironment) > 0, has(self.rollback)].filter(x, x).size() == 1",message="exactly one of 'manualPromotion', 'automatedPromotion' or 'rollback' must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.rollback) != (has(self.snapshot) && size(self.snapshot) > 0)",message="'snapshot' must be specified for a promotion, and must not be specified for a rollback"
 type PromotionRunSpec struct {
 
 	// Snapshot refers to the name of a Snapshot resource defined within the namespace, used to promote container images between Environments.
-	Snapshot string `json:"snapshot"`
+	// Required for a manual or automated promotion; a rollback specifies the Snapshot to roll back to within 'rollback' instead.
+	// +optional
+	Snapshot string `json:"snapshot,omitempty"`
 
 	// Application is the name of an Application resource defined within the namespaced, and which is the target of the promotion
 	Application string `json:"application"`
 
 	// ManualPromotion is for fields specific to manual promotion.
-	// Only one field should be defined: either 'manualPromotion' or 'automatedPromotion', but not both.
-	ManualPromotion ManualPromotionConfiguration `json:"manualPromotion,omitempty"`
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	ManualPromotion *ManualPromotionConfiguration `json:"manualPromotion,omitempty"`
 
 	// AutomatedPromotion is for fields specific to automated promotion
-	// Only one field should be defined: either 'manualPromotion' or 'automatedPromotion', but not both.
-	AutomatedPromotion AutomatedPromotionConfiguration `json:"automatedPromotion,omitempty"`
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	AutomatedPromotion *AutomatedPromotionConfiguration `json:"automatedPromotion,omitempty"`
+
+	// Rollback is for fields specific to a rollback: the deployment of an Environment is reverted to a previously
+	// deployed Snapshot.
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	Rollback *RollbackConfiguration `json:"rollback,omitempty"`
+
+	// Timeout is the maximum duration of the whole promotion, measured from its start: once exceeded, the promotion
+	// completes with the 'TimedOut' result. If not set, the promotion does not time out.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// EnvironmentTimeout is the maximum duration of the promotion to a single environment, measured from the start of
+	// its environment status step: once exceeded, the step fails. If not set, the steps do not time out.
+	// +optional
+	EnvironmentTimeout *metav1.Duration `json:"environmentTimeout,omitempty"`
+
+	// RetryPolicy defines how many times the promotion to an environment is retried once its step fails. If not set,
+	// failed steps are not retried.
+	// +optional
+	RetryPolicy *PromotionRunRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Cancel requests the cancellation of the promotion: the promotion completes with the 'Cancelled' result, and the
+	// environments promoted to so far are left as they are. This is the only field of the spec which may be updated,
+	// and a cancelled promotion cannot be resumed.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// PromotionRunRetryPolicy defines how failed environment status steps are retried.
+type PromotionRunRetryPolicy struct {
+	// MaxRetries is the maximum number of times the promotion to a single environment is retried after a failure.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int `json:"maxRetries"`
+
+	// Backoff is the duration to wait before retrying a failed step. If not set, the step is retried immediately.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
+// PromotionType describes the type of promotion performed by a PromotionRun.
+type PromotionType string
+
+const (
+	ManualPromotionType    PromotionType = "Manual"
+	AutomatedPromotionType PromotionType = "Automated"
+	RollbackPromotionType  PromotionType = "Rollback"
+)
+
+// PromotionType returns the type of promotion that is set on the spec.
+// An error is returned if either no promotion, or more than one promotion, is set. For compatibility with
+// PromotionRuns created before the promotions were optional, a promotion without an environment is considered unset.
+func (s *PromotionRunSpec) PromotionType() (PromotionType, error) {
+	var promotionType PromotionType
+	set := 0
+	if s.ManualPromotion != nil && s.ManualPromotion.TargetEnvironment != "" {
+		promotionType = ManualPromotionType
+		set++
+	}
+	if s.AutomatedPromotion != nil && s.AutomatedPromotion.InitialEnvironment != "" {
+		promotionType = AutomatedPromotionType
+		set++
+	}
+	if s.Rollback != nil {
+		promotionType = RollbackPromotionType
+		set++
+	}
+
+	switch set {
+	case 0:
+		return "", errors.New(MissingPromotionTarget)
+	case 1:
+		return promotionType, nil
+	default:
+		return "", errors.New(MultiplePromotionTargets)
+	}
 }
 
 // ManualPromotionConfiguration defines promotion parameters specific to manual promotion: the target environment to promote to.
@@ -51,6 +135,33 @@ type AutomatedPromotionConfiguration struct {
 	InitialEnvironment string `json:"initialEnvironment"`
 }
 
+// RollbackToPreviousSnapshot is the value of RollbackConfiguration's ToSnapshot field which rolls an Environment back
+// to the Snapshot that was deployed to it before the current one.
+const RollbackToPreviousSnapshot = "previous"
+
+// RollbackConfiguration defines parameters specific to a rollback: the environment to roll back, and the Snapshot to
+// roll it back to.
+type RollbackConfiguration struct {
+	// TargetEnvironment is the environment to roll back
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ToSnapshot is the name of a Snapshot resource defined within the namespace to roll back to, or 'previous' to roll
+	// back to the Snapshot that was deployed to the environment before the current one.
+	// +kubebuilder:default=previous
+	// +optional
+	ToSnapshot string `json:"toSnapshot,omitempty"`
+}
+
+// PromotionRunRollbackStatus records the Snapshots involved in a rollback.
+type PromotionRunRollbackStatus struct {
+	// Snapshot is the name of the Snapshot the environment was rolled back to, with 'previous' resolved to the
+	// actual Snapshot.
+	Snapshot string `json:"snapshot"`
+
+	// ReplacedSnapshot is the name of the Snapshot that was deployed to the environment before the rollback.
+	ReplacedSnapshot string `json:"replacedSnapshot"`
+}
+
 // PromotionRunStatus defines the observed state of PromotionRun
 type PromotionRunStatus struct {
 
@@ -72,9 +183,75 @@ type PromotionRunStatus struct {
 	// PromotionStartTime is set to the value when the PromotionRun Reconciler first started the promotion.
 	PromotionStartTime metav1.Time `json:"promotionStartTime,omitempty"`
 
+	// Rollback records the Snapshots involved in a rollback, once the Snapshot to roll back to has been resolved.
+	// Only set for a rollback.
+	// +optional
+	Rollback *PromotionRunRollbackStatus `json:"rollback,omitempty"`
+
+	// Approvals records the approvals of the environments which require an approval before being promoted to, one
+	// entry per environment. The promotion is in the 'Waiting' state while an approval is pending.
+	// +optional
+	// +listType=map
+	// +listMapKey=environmentName
+	Approvals []PromotionRunEnvironmentApproval `json:"approvals,omitempty"`
+
 	Conditions []PromotionRunCondition `json:"conditions,omitempty"`
 }
 
+// PromotionRunEnvironmentApproval records the approval of the promotion to a single environment.
+type PromotionRunEnvironmentApproval struct {
+	// EnvironmentName is the name of the environment which requires the approval
+	EnvironmentName string `json:"environmentName"`
+
+	// State is the state of the approval
+	State ApprovalState `json:"state"`
+
+	// RequiredApprovals is the number of approvals required by the approval policy of the environment
+	RequiredApprovals int `json:"requiredApprovals"`
+
+	// Decisions are the decisions of the approvers, in the order they were made
+	// +optional
+	Decisions []ApprovalDecision `json:"decisions,omitempty"`
+}
+
+// ApprovalDecision records the decision of a single approver.
+type ApprovalDecision struct {
+	// Approver is the name of the user who made the decision
+	Approver string `json:"approver"`
+
+	// Decision is whether the approver approved or rejected the promotion
+	Decision ApprovalDecisionType `json:"decision"`
+
+	// Comment is an optional comment of the approver
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Time is the time the decision was made
+	Time metav1.Time `json:"time"`
+}
+
+// ApprovalState is the state of the approval of the promotion to an environment.
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected
+type ApprovalState string
+
+const (
+	// ApprovalState_Pending: the environment has not received the required number of approvals yet
+	ApprovalState_Pending ApprovalState = "Pending"
+	// ApprovalState_Approved: the environment has received the required number of approvals
+	ApprovalState_Approved ApprovalState = "Approved"
+	// ApprovalState_Rejected: an approver rejected the promotion to the environment
+	ApprovalState_Rejected ApprovalState = "Rejected"
+)
+
+// ApprovalDecisionType is the decision of an approver.
+// +kubebuilder:validation:Enum=Approved;Rejected
+type ApprovalDecisionType string
+
+const (
+	ApprovalDecision_Approved ApprovalDecisionType = "Approved"
+	ApprovalDecision_Rejected ApprovalDecisionType = "Rejected"
+)
+
 // PromotionRunState defines the 3 states of an Promotion resource.
 type PromotionRunState string
 
@@ -88,8 +265,10 @@ const (
 type PromotionRunCompleteResult string
 
 const (
-	PromotionRunCompleteResult_Success PromotionRunCompleteResult = "Success"
-	PromotionRunCompleteResult_Failure PromotionRunCompleteResult = "Failure"
+	PromotionRunCompleteResult_Success   PromotionRunCompleteResult = "Success"
+	PromotionRunCompleteResult_Failure   PromotionRunCompleteResult = "Failure"
+	PromotionRunCompleteResult_TimedOut  PromotionRunCompleteResult = "TimedOut"
+	PromotionRunCompleteResult_Cancelled PromotionRunCompleteResult = "Cancelled"
 )
 
 // PromotionRunEnvironmentStatus represents the set of steps taken during the  current promotion:
@@ -103,11 +282,17 @@ type PromotionRunEnvironmentStatus struct {
 	// EnvironmentName is the name of the environment that was promoted to in this step
 	EnvironmentName string `json:"environmentName"`
 
-	// Status is/was the result of promoting to that environment.
+	// Status is/was the result of promoting to that environment. It is 'Waiting For Approval' while the promotion to an
+	// environment which requires an approval is waiting for it to be granted.
 	Status PromotionRunEnvironmentStatusField `json:"status"`
 
 	// DisplayStatus is human-readible description of the current state/status.
 	DisplayStatus string `json:"displayStatus"`
+
+	// StartTime is the time the promotion to the environment started in this step, used to enforce the
+	// environment timeout.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
 }
 
 // PromotionRunEnvironmentStatusField are the state values for promotion to individual enviroments, as
@@ -117,11 +302,14 @@ type PromotionRunEnvironmentStatusField string
 const (
 	PromotionRunEnvironmentStatus_Success    PromotionRunEnvironmentStatusField = "Success"
 	PromotionRunEnvironmentStatus_InProgress PromotionRunEnvironmentStatusField = "In Progress"
+	PromotionRunEnvironmentStatus_WaitingForApproval PromotionRunEnvironmentStatusField = "Waiting For Approval"
 	PromotionRunEnvironmentStatus_Failed     PromotionRunEnvironmentStatusField = "Failed"
 )
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // PromotionRun is the Schema for the promotionruns API
 // +kubebuilder:resource:path=promotionruns,shortName=apr;promotion
@@ -169,6 +357,11 @@ type PromotionRunCondition struct {
 	// Reason is a unique, one-word, CamelCase reason for the condition's last transition.
 	// +optional
 	Reason PromotionRunReasonType `json:"reason"`
+
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // PromotionRunConditionType represents type of GitOpsDeployment condition.
@@ -176,6 +369,8 @@ type PromotionRunConditionType string
 
 const (
 	PromotionRunConditionErrorOccurred PromotionRunConditionType = "ErrorOccurred"
+	// PromotionRunConditionCompleted is True once the promotion is complete, with a reason describing its result
+	PromotionRunConditionCompleted PromotionRunConditionType = "Completed"
 )
 
 // PromotionRunConditionStatus is a type which represents possible comparison results
@@ -195,4 +390,10 @@ type PromotionRunReasonType string
 
 const (
 	PromotionRunReasonErrorOccurred PromotionRunReasonType = "ErrorOccurred"
+	PromotionRunReasonSucceeded     PromotionRunReasonType = "Succeeded"
+	PromotionRunReasonFailed        PromotionRunReasonType = "Failed"
+	PromotionRunReasonTimedOut      PromotionRunReasonType = "TimedOut"
+	PromotionRunReasonCancelled     PromotionRunReasonType = "Cancelled"
+	// PromotionRunReasonRetriesExhausted is used when the promotion to an environment failed after all retries
+	PromotionRunReasonRetriesExhausted PromotionRunReasonType = "RetriesExhausted"
 )
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RolloutStrategy describes how a new version of a component is rolled out within an Environment, as opposed to the
+// DeploymentStrategy of the Environment, which describes when the Environment is promoted to.
+// Only the field matching the type may be specified.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Rolling' || !has(self.rolling)",message="'rolling' may only be specified for the Rolling type"
+// +kubebuilder:validation:XValidation:rule="self.type == 'BlueGreen' || !has(self.blueGreen)",message="'blueGreen' may only be specified for the BlueGreen type"
+// +kubebuilder:validation:XValidation:rule="self.type == 'Canary' ? has(self.canary) : !has(self.canary)",message="'canary' must be specified for the Canary type, and only for it"
+type RolloutStrategy struct {
+	// Type is the type of the rollout strategy.
+	Type RolloutStrategyType `json:"type"`
+
+	// Rolling configures a rolling update. Optional: the defaults of the cluster apply if not set.
+	// +optional
+	Rolling *RollingRolloutStrategy `json:"rolling,omitempty"`
+
+	// BlueGreen configures a blue-green rollout. Optional: the preview version is promoted automatically if not set.
+	// +optional
+	BlueGreen *BlueGreenRolloutStrategy `json:"blueGreen,omitempty"`
+
+	// Canary configures a canary rollout. Required for the Canary type.
+	// +optional
+	Canary *CanaryRolloutStrategy `json:"canary,omitempty"`
+}
+
+// RolloutStrategyType defines the available rollout strategies.
+// +kubebuilder:validation:Enum=Rolling;BlueGreen;Canary
+type RolloutStrategyType string
+
+const (
+	// RolloutStrategy_Rolling: the pods of the previous version are progressively replaced by pods of the new version
+	RolloutStrategy_Rolling RolloutStrategyType = "Rolling"
+
+	// RolloutStrategy_BlueGreen: the new version is deployed alongside the previous version, and receives all traffic
+	// once promoted
+	RolloutStrategy_BlueGreen RolloutStrategyType = "BlueGreen"
+
+	// RolloutStrategy_Canary: the traffic is progressively shifted to the new version, following the canary steps
+	RolloutStrategy_Canary RolloutStrategyType = "Canary"
+)
+
+// RollingRolloutStrategy configures a rolling update.
+type RollingRolloutStrategy struct {
+	// MaxSurge is the maximum number (or percentage) of pods which can be created above the desired number of pods.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// MaxUnavailable is the maximum number (or percentage) of pods which can be unavailable during the update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// BlueGreenRolloutStrategy configures a blue-green rollout.
+type BlueGreenRolloutStrategy struct {
+	// AutoPromotionEnabled defines whether the new version is promoted to receive all traffic as soon as it is ready.
+	// If false, the new version is only promoted once the rollout is resumed. Defaults to true.
+	// +optional
+	AutoPromotionEnabled *bool `json:"autoPromotionEnabled,omitempty"`
+
+	// ScaleDownDelay is the duration to wait before scaling down the previous version once the new version is promoted.
+	// +optional
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty"`
+}
+
+// CanaryRolloutStrategy configures a canary rollout.
+type CanaryRolloutStrategy struct {
+	// Steps are the steps of the canary rollout, performed in order. Once all steps are performed, the new version
+	// receives all traffic.
+	// +kubebuilder:validation:MinItems=1
+	Steps []CanaryStep `json:"steps"`
+}
+
+// CanaryStep is a single step of a canary rollout: either a traffic weight to set, or a pause.
+// +kubebuilder:validation:XValidation:rule="has(self.setWeight) != has(self.pause)",message="exactly one of 'setWeight' or 'pause' must be specified"
+type CanaryStep struct {
+	// SetWeight is the percentage of the traffic to send to the new version.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SetWeight *int32 `json:"setWeight,omitempty"`
+
+	// Pause pauses the rollout, for example to analyze the new version before shifting more traffic to it.
+	// +optional
+	Pause *CanaryPause `json:"pause,omitempty"`
+}
+
+// CanaryPause pauses a canary rollout.
+type CanaryPause struct {
+	// Duration is the duration of the pause. If not set, the rollout is paused until it is resumed.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/redhat-appstudio/application-api/api/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// snapshotConversionData contains the Snapshot fields which cannot be represented in the other version.
+type snapshotConversionData struct {
+	// ArtifactsUnstableFields is the unstructured artifacts placeholder, which was removed in v1beta1
+	ArtifactsUnstableFields *apiextensionsv1.JSON `json:"artifactsUnstableFields,omitempty"`
+}
+
+// ConvertTo converts this Snapshot to the hub version (v1beta1).
+func (src *Snapshot) ConvertTo(dst *v1beta1.Snapshot) error {
+	restored := snapshotConversionData{}
+	preserved := snapshotConversionData{ArtifactsUnstableFields: src.Spec.Artifacts.UnstableFields}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = v1beta1.SnapshotSpec{
+		Application:        src.Spec.Application,
+		DisplayName:        src.Spec.DisplayName,
+		DisplayDescription: src.Spec.DisplayDescription,
+	}
+	for _, component := range src.Spec.Components {
+		dst.Spec.Components = append(dst.Spec.Components, v1beta1.SnapshotComponent{
+			Name:           component.Name,
+			ContainerImage: component.ContainerImage,
+			Source:         convertComponentSourceTo(component.Source),
+		})
+	}
+	for _, artifacts := range src.Spec.Artifacts.Components {
+		dstArtifacts := v1beta1.SnapshotComponentArtifacts{
+			Name:             artifacts.Name,
+			ImageDigest:      artifacts.ImageDigest,
+			SBOM:             artifacts.SBOM,
+			Signatures:       append([]string(nil), artifacts.Signatures...),
+			Attestations:     append([]string(nil), artifacts.Attestations...),
+			BuildPipelineRun: artifacts.BuildPipelineRun,
+		}
+		if artifacts.Source != nil {
+			source := v1beta1.SnapshotArtifactSource(*artifacts.Source)
+			dstArtifacts.Source = &source
+		}
+		dst.Spec.Artifacts.Components = append(dst.Spec.Artifacts.Components, dstArtifacts)
+	}
+
+	dst.Status = v1beta1.SnapshotStatus{
+		Conditions: copyConditions(src.Status.Conditions),
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1beta1) to this Snapshot.
+func (dst *Snapshot) ConvertFrom(src *v1beta1.Snapshot) error {
+	restored := snapshotConversionData{}
+	preserved := snapshotConversionData{}
+	if err := convertObjectMeta(src.ObjectMeta, &dst.ObjectMeta, &restored, &preserved); err != nil {
+		return err
+	}
+
+	dst.Spec = SnapshotSpec{
+		Application:        src.Spec.Application,
+		DisplayName:        src.Spec.DisplayName,
+		DisplayDescription: src.Spec.DisplayDescription,
+		Artifacts: SnapshotArtifacts{
+			UnstableFields: restored.ArtifactsUnstableFields,
+		},
+	}
+	for _, component := range src.Spec.Components {
+		dst.Spec.Components = append(dst.Spec.Components, SnapshotComponent{
+			Name:           component.Name,
+			ContainerImage: component.ContainerImage,
+			Source:         convertComponentSourceFrom(component.Source),
+		})
+	}
+	for _, artifacts := range src.Spec.Artifacts.Components {
+		dstArtifacts := SnapshotComponentArtifacts{
+			Name:             artifacts.Name,
+			ImageDigest:      artifacts.ImageDigest,
+			SBOM:             artifacts.SBOM,
+			Signatures:       append([]string(nil), artifacts.Signatures...),
+			Attestations:     append([]string(nil), artifacts.Attestations...),
+			BuildPipelineRun: artifacts.BuildPipelineRun,
+		}
+		if artifacts.Source != nil {
+			source := SnapshotArtifactSource(*artifacts.Source)
+			dstArtifacts.Source = &source
+		}
+		dst.Spec.Artifacts.Components = append(dst.Spec.Artifacts.Components, dstArtifacts)
+	}
+
+	dst.Status = SnapshotStatus{
+		Conditions: copyConditions(src.Status.Conditions),
+	}
+
+	return nil
+}
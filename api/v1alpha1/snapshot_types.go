@@ -56,18 +56,83 @@ type SnapshotComponent struct {
 	Source ComponentSource `json:"source,omitempty"`
 }
 
-// SnapshotArtifacts is a placeholder section for 'artifact links' we want to maintain to other AppStudio resources.
+// SnapshotArtifacts contains the 'artifact links' we want to maintain to other AppStudio resources.
 //
-// For example: here I'm imagining we might want to keep track of container image <=> (source code repo, commit sha) links,
-// Which might be useful to present to the user within the UI.
+// For example: the container image <=> (source code repo, commit sha) links of each component, which are used
+// by release tooling and might be useful to present to the user within the UI.
 type SnapshotArtifacts struct {
 
-	// NOTE: This field (and struct) are placeholders.
+	// Components contains the artifacts of the components of the Snapshot, one entry per component.
+	// Optional.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []SnapshotComponentArtifacts `json:"components,omitempty"`
+
+	// NOTE: This field is a placeholder, and is kept for compatibility with existing Snapshots.
 	// - Until this API is stabilized, consumers of the API may store any unstructured JSON/YAML data here,
 	//   but no backwards compatibility will be preserved.
 	UnstableFields *apiextensionsv1.JSON `json:"unstableFields,omitempty"`
 }
 
+// SnapshotComponentArtifacts contains the artifacts of a single component of a Snapshot.
+type SnapshotComponentArtifacts struct {
+
+	// Name is the name of the component, matching the name of an entry in the Snapshot's components.
+	// Required.
+	// +required
+	Name string `json:"name"`
+
+	// Source is the source code revision the component's container image was built from.
+	// Optional.
+	// +optional
+	Source *SnapshotArtifactSource `json:"source,omitempty"`
+
+	// ImageDigest is the digest of the component's container image.
+	// Example: sha256:1b2dd8b2d2d8b5e1ab8a3e7f0ee0c5b1dd3d31ac3b1b6c16b2d0c5ae1c1a8c4d.
+	// Optional.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// SBOM is a reference to the Software Bill of Materials of the component's container image, for example an
+	// OCI artifact reference or a URL.
+	// Optional.
+	// +optional
+	SBOM string `json:"sbom,omitempty"`
+
+	// Signatures are references to the signatures of the component's container image, for example OCI artifact references.
+	// Optional.
+	// +optional
+	Signatures []string `json:"signatures,omitempty"`
+
+	// Attestations are references to the attestations of the component's container image, for example the
+	// build provenance, as OCI artifact references.
+	// Optional.
+	// +optional
+	Attestations []string `json:"attestations,omitempty"`
+
+	// BuildPipelineRun is the name of the PipelineRun, defined within the same namespace, which built the component's container image.
+	// Optional.
+	// +optional
+	BuildPipelineRun string `json:"buildPipelineRun,omitempty"`
+}
+
+// SnapshotArtifactSource describes the source code revision a component's container image was built from.
+type SnapshotArtifactSource struct {
+
+	// RepositoryURL is the URL of the git repository.
+	// Required.
+	// +required
+	RepositoryURL string `json:"repositoryURL"`
+
+	// CommitSHA is the commit id (SHA-1 checksum) the container image was built from.
+	// Example: 41fbdb124775323f58fd5ce93c70bb7d79c20650.
+	// Required.
+	// +required
+	CommitSHA string `json:"commitSHA"`
+}
+
 // SnapshotStatus defines the observed state of Snapshot
 type SnapshotStatus struct {
 	// Conditions represent the latest available observations for the Snapshot
@@ -75,8 +140,10 @@ type SnapshotStatus struct {
 	Conditions []metav1.Condition `json:"conditions"`
 }
 
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 
 // Snapshot is the Schema for the snapshots API
 // +kubebuilder:resource:path=snapshots,shortName=as;snapshot
@@ -88,6 +155,17 @@ type Snapshot struct {
 	Status SnapshotStatus `json:"status,omitempty"`
 }
 
+// GetComponentArtifacts returns the artifacts of the component with the given name, or nil
+// if the Snapshot has no artifacts for that component.
+func (s *Snapshot) GetComponentArtifacts(componentName string) *SnapshotComponentArtifacts {
+	for i := range s.Spec.Artifacts.Components {
+		if s.Spec.Artifacts.Components[i].Name == componentName {
+			return &s.Spec.Artifacts.Components[i]
+		}
+	}
+	return nil
+}
+
 //+kubebuilder:object:root=true
 
 // SnapshotList contains a list of Snapshot
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "fmt"
+
+// SnapshotEnvironmentBindingApplicationEnvironmentIndex is the name of the field index of SnapshotEnvironmentBindings
+// by their spec.application and spec.environment. The keys of the index are built by
+// SnapshotEnvironmentBindingApplicationEnvironmentKey, so that the (at most one) binding of an Application to an
+// Environment can be looked up directly, for example with controller-runtime:
+//
+//	mgr.GetFieldIndexer().IndexField(ctx, &SnapshotEnvironmentBinding{}, SnapshotEnvironmentBindingApplicationEnvironmentIndex,
+//		func(obj client.Object) []string {
+//			return obj.(*SnapshotEnvironmentBinding).ApplicationEnvironmentKey()
+//		})
+const SnapshotEnvironmentBindingApplicationEnvironmentIndex = "spec.application,spec.environment"
+
+// SnapshotEnvironmentBindingApplicationEnvironmentKey returns the key of the given Application and Environment in the
+// SnapshotEnvironmentBindingApplicationEnvironmentIndex. Application and Environment names cannot contain a '/', so
+// distinct pairs always have distinct keys.
+func SnapshotEnvironmentBindingApplicationEnvironmentKey(application, environment string) string {
+	return application + "/" + environment
+}
+
+// ApplicationEnvironmentKey returns the keys of the binding in the SnapshotEnvironmentBindingApplicationEnvironmentIndex.
+// No key is returned if the binding does not specify both an application and an environment.
+func (binding *SnapshotEnvironmentBinding) ApplicationEnvironmentKey() []string {
+	if binding.Spec.Application == "" || binding.Spec.Environment == "" {
+		return nil
+	}
+	return []string{SnapshotEnvironmentBindingApplicationEnvironmentKey(binding.Spec.Application, binding.Spec.Environment)}
+}
+
+// IndexSnapshotEnvironmentBindingByApplicationEnvironment is an index function, compatible with the client-go
+// cache.IndexFunc type, which indexes SnapshotEnvironmentBindings by their application and environment.
+func IndexSnapshotEnvironmentBindingByApplicationEnvironment(obj interface{}) ([]string, error) {
+	binding, ok := obj.(*SnapshotEnvironmentBinding)
+	if !ok {
+		return nil, fmt.Errorf("object of type %T is not a SnapshotEnvironmentBinding", obj)
+	}
+	return binding.ApplicationEnvironmentKey(), nil
+}
@@ -52,6 +52,13 @@ type SnapshotEnvironmentBindingSpec struct {
 	// Required.
 	// +required
 	Components []BindingComponent `json:"components"`
+
+	// Suspend pauses the reconciliation of the binding, and the sync of all of its components to the Environment,
+	// without deleting the binding or what it has deployed. Changes to the binding, its Snapshot or the GitOps
+	// repository are not deployed until the binding is resumed.
+	// Optional.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // BindingComponent contains individual component data
@@ -66,6 +73,12 @@ type BindingComponent struct {
 	// Optional
 	// +optional
 	Configuration BindingComponentConfiguration `json:"configuration,omitempty"`
+
+	// Suspend pauses the sync of the component to the Environment, while the other components of the binding are
+	// still deployed.
+	// Optional.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
 }
 
 // BindingComponentConfiguration describes GitOps repository customizations that are specific to the
@@ -92,6 +105,11 @@ type BindingComponentConfiguration struct {
 	// Optional.
 	// +optional
 	Env []EnvVarPair `json:"env,omitempty"`
+
+	// RolloutStrategy overrides the rollout strategy of the Environment for the component.
+	// Optional.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
 }
 
 // EnvVarPair describes environment variables to use for the component
@@ -163,6 +181,73 @@ type SnapshotEnvironmentBindingStatus struct {
 	// ComponentDeploymentConditions describes the deployment status of all of the Components of the Application.
 	// This status is updated by the Gitops Service's SnapshotEnvironmentBinding controller
 	ComponentDeploymentConditions []metav1.Condition `json:"componentDeploymentConditions,omitempty"`
+
+	// SuspendedSince is the time the binding was suspended at, if spec.suspend is true.
+	// +optional
+	SuspendedSince *metav1.Time `json:"suspendedSince,omitempty"`
+
+	// SuspendedComponents are the components whose sync is individually suspended, by the suspend field of their
+	// spec.components entry.
+	// +optional
+	SuspendedComponents []SuspendedComponentStatus `json:"suspendedComponents,omitempty"`
+
+	// DeploymentHistory records the Snapshots deployed by the binding, from the oldest to the most recent one. Only the
+	// most recent MaxDeploymentHistory deployments are kept.
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	DeploymentHistory []DeploymentHistoryEntry `json:"deploymentHistory,omitempty"`
+}
+
+// MaxDeploymentHistory is the maximum number of entries of the deployment history of a SnapshotEnvironmentBinding.
+const MaxDeploymentHistory = 10
+
+// DeploymentHistoryEntry describes the deployment of a Snapshot by a SnapshotEnvironmentBinding.
+type DeploymentHistoryEntry struct {
+
+	// Snapshot is the name of the Snapshot that was deployed.
+	Snapshot string `json:"snapshot"`
+
+	// Components are the components that were deployed, with the commit ID of the GitOps repository each was deployed
+	// from. They are recorded once the deployment has completed.
+	// +optional
+	Components []DeploymentHistoryComponent `json:"components,omitempty"`
+
+	// PromotionRun is the name of the PromotionRun that caused the deployment, if any.
+	// +optional
+	PromotionRun string `json:"promotionRun,omitempty"`
+
+	// DeployedBy identifies the user or controller that caused the deployment, if known.
+	// +optional
+	DeployedBy string `json:"deployedBy,omitempty"`
+
+	// StartTime is the time the deployment of the Snapshot started at.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time all of the components of the Snapshot were deployed at. It is not set if the
+	// deployment has not completed yet, or was superseded by the deployment of another Snapshot before it completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// DeploymentHistoryComponent describes a component deployed as part of a DeploymentHistoryEntry.
+type DeploymentHistoryComponent struct {
+
+	// Name is the name of the component.
+	Name string `json:"name"`
+
+	// CommitID is the commit ID of the GitOps repository the component was deployed from.
+	// +optional
+	CommitID string `json:"commitID,omitempty"`
+}
+
+// SuspendedComponentStatus describes a component of a SnapshotEnvironmentBinding whose sync is suspended.
+type SuspendedComponentStatus struct {
+
+	// Name is the name of the component.
+	Name string `json:"name"`
+
+	// Since is the time the component was suspended at.
+	Since metav1.Time `json:"since"`
 }
 
 // BindingStatusGitOpsDeployment describes an individual reference
@@ -179,15 +264,42 @@ type BindingStatusGitOpsDeployment struct {
 	GitOpsDeployment string `json:"gitopsDeployment,omitempty"`
 
 	// GitOpsDeploymentSyncStatus is the sync status of the deployment owned by the binding
-	GitOpsDeploymentSyncStatus string `json:"syncStatus,omitempty"`
+	// +optional
+	GitOpsDeploymentSyncStatus SyncStatusCode `json:"syncStatus,omitempty"`
 
 	// GitOpsDeploymentHealthStatus is the health status of the deployment owned by the binding
-	GitOpsDeploymentHealthStatus string `json:"health,omitempty"`
+	// +optional
+	GitOpsDeploymentHealthStatus HealthStatusCode `json:"health,omitempty"`
 
 	// GitOpsDeploymentCommitID is the commit ID of the GitOpsDeployment
 	GitOpsDeploymentCommitID string `json:"commitID,omitempty"`
 }
 
+// SyncStatusCode is the sync status of a GitOpsDeployment, i.e. whether the resources deployed to the Environment match
+// the resources of the GitOps repository.
+// +kubebuilder:validation:Enum=Synced;OutOfSync;Unknown
+type SyncStatusCode string
+
+const (
+	SyncStatusCode_Synced    SyncStatusCode = "Synced"
+	SyncStatusCode_OutOfSync SyncStatusCode = "OutOfSync"
+	SyncStatusCode_Unknown   SyncStatusCode = "Unknown"
+)
+
+// HealthStatusCode is the health status of a GitOpsDeployment, i.e. the aggregated health of the resources it deployed.
+// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded;Suspended;Missing;Unknown
+type HealthStatusCode string
+
+const (
+	HealthStatusCode_Healthy     HealthStatusCode = "Healthy"
+	HealthStatusCode_Progressing HealthStatusCode = "Progressing"
+	HealthStatusCode_Degraded    HealthStatusCode = "Degraded"
+	HealthStatusCode_Suspended   HealthStatusCode = "Suspended"
+	HealthStatusCode_Missing     HealthStatusCode = "Missing"
+	HealthStatusCode_Unknown     HealthStatusCode = "Unknown"
+)
+
+//+genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
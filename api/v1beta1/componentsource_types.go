@@ -0,0 +1,96 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ComponentSrcType describes the type of
+// the src for the Component.
+// Only one of the following location type may be specified.
+// +kubebuilder:validation:Enum=Git;Image
+type ComponentSrcType string
+
+const (
+	GitComponentSrcType   ComponentSrcType = "Git"
+	ImageComponentSrcType ComponentSrcType = "Image"
+)
+
+type GitSource struct {
+	// An HTTPS URL representing the git repository to create the component from.
+	URL string `json:"url"`
+
+	// Specify a branch/tag/commit id. If not specified, default is `main`/`master`.
+	// Example: devel.
+	// Optional.
+	Revision string `json:"revision,omitempty"`
+
+	// A relative path inside the git repo containing the component
+	// Example: folderA/folderB/gitops.
+	// Optional.
+	Context string `json:"context,omitempty"`
+
+	// If specified, the devfile at the URI will be used for the component. Can be a local path inside the repository, or an external URL.
+	// Example: https://raw.githubusercontent.com/devfile-samples/devfile-sample-java-springboot-basic/main/devfile.yaml.
+	// Optional.
+	DevfileURL string `json:"devfileUrl,omitempty"`
+
+	// If specified, the dockerfile at the URI will be used for the component. Can be a local path inside the repository, or an external URL.
+	// Optional.
+	DockerfileURL string `json:"dockerfileUrl,omitempty"`
+}
+
+// ImageSource describes an existing container image to create the component from.
+type ImageSource struct {
+	// The container image reference to create the component from.
+	// Example: quay.io/someorg/somerepository:latest.
+	// Required.
+	// +required
+	ContainerImage string `json:"containerImage"`
+
+	// The digest of the container image. If specified, the image is pinned to this digest rather than the tag.
+	// Example: sha256:1b2dd8b2d2d8b5e1ab8a3e7f0ee0c5b1dd3d31ac3b1b6c16b2d0c5ae1c1a8c4d.
+	// Optional.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// The name of a Kubernetes image pull secret, in the same namespace, used to pull the container image.
+	// Optional.
+	// +optional
+	PullSecret string `json:"pullSecret,omitempty"`
+
+	// The list of platforms the container image is available for.
+	// Example: linux/amd64.
+	// Optional.
+	// +optional
+	Platforms []string `json:"platforms,omitempty"`
+}
+
+// ComponentSource describes the Component source
+// +kubebuilder:validation:MaxProperties=1
+type ComponentSource struct {
+	ComponentSourceUnion `json:",inline"`
+}
+
+// +union
+type ComponentSourceUnion struct {
+	// Git Source for a Component.
+	// Optional.
+	GitSource *GitSource `json:"git,omitempty"`
+
+	// Image Source for a Component.
+	// Optional.
+	ImageSource *ImageSource `json:"image,omitempty"`
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// Condition types and reasons used within the status conditions of the v1beta1 resources. The helpers of the
+// pkg/conditions package may be used to set and query these conditions.
+
+// Constants used with EnvironmentStatus's Conditions field
+const (
+	EnvironmentConditionReady         = "Ready"
+	EnvironmentConditionErrorOccurred = "ErrorOccurred"
+
+	EnvironmentReasonOK            = "OK"
+	EnvironmentReasonErrorOccurred = "ErrorOccurred"
+)
+
+// Constants used with SnapshotStatus's Conditions field
+const (
+	SnapshotConditionTestSucceeded     = "AppStudioTestSucceeded"
+	SnapshotConditionIntegrationStatus = "AppStudioIntegrationStatus"
+	SnapshotConditionAutoReleased      = "AutoReleased"
+
+	SnapshotReasonPassed     = "Passed"
+	SnapshotReasonFailed     = "Failed"
+	SnapshotReasonInProgress = "InProgress"
+	SnapshotReasonInvalid    = "Invalid"
+)
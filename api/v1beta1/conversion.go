@@ -0,0 +1,29 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// v1beta1 is the hub version of the Environment, Snapshot and PromotionRun kinds: the other versions of these kinds
+// convert to and from this version. See the conversion functions of the v1alpha1 package.
+
+// Hub marks this type as a conversion hub.
+func (*Environment) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*Snapshot) Hub() {}
+
+// Hub marks this type as a conversion hub.
+func (*PromotionRun) Hub() {}
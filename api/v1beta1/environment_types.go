@@ -0,0 +1,306 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EnvironmentSpec defines the desired state of Environment
+type EnvironmentSpec struct {
+
+	// DisplayName is the user-visible, user-definable name for the environment (but not used for functional requirements)
+	DisplayName string `json:"displayName"`
+
+	// DeploymentStrategy is the promotion strategy for the Environment
+	// See Environment API doc for details.
+	DeploymentStrategy DeploymentStrategyType `json:"deploymentStrategy"`
+
+	// ParentEnvironment references another Environment defined in the namespace: when automated promotion is enabled,
+	// promotions to the parent environment will cause this environment to be promoted to.
+	// See Environment API doc for details.
+	ParentEnvironment string `json:"parentEnvironment,omitempty"`
+
+	// Tags are a user-visisble, user-definable set of tags that can be applied to the environment
+	Tags []string `json:"tags,omitempty"`
+
+	// Configuration contains environment-specific details for Applications/Components that are deployed to
+	// the Environment.
+	Configuration EnvironmentConfiguration `json:"configuration,omitempty"`
+
+	// ApprovalPolicy requires promotions to the Environment to be approved before the Environment is promoted to.
+	// If not set, promotions to the Environment do not require any approval.
+	// Optional.
+	// +optional
+	ApprovalPolicy *ApprovalPolicy `json:"approvalPolicy,omitempty"`
+
+	// DeploymentSchedule restricts the times at which the Environment may be promoted to, for example to business
+	// hours, and excludes change freeze periods. If not set, the Environment may be promoted to at any time.
+	// Optional.
+	// +optional
+	DeploymentSchedule *DeploymentSchedule `json:"deploymentSchedule,omitempty"`
+
+	// ClusterConfiguration contains the configuration of the target cluster of the Environment, including the
+	// credentials for connecting to it.
+	// Optional.
+	// +optional
+	ClusterConfiguration *ClusterConfiguration `json:"clusterConfiguration,omitempty"`
+}
+
+// ApprovalPolicy defines who must approve a promotion to an Environment. If neither approvers nor approver groups are
+// specified, any user may approve.
+type ApprovalPolicy struct {
+	// Approvers are the names of the users who may approve a promotion.
+	// Optional.
+	// +optional
+	Approvers []string `json:"approvers,omitempty"`
+
+	// ApproverGroups are the names of the groups whose members may approve a promotion.
+	// Optional.
+	// +optional
+	ApproverGroups []string `json:"approverGroups,omitempty"`
+
+	// RequiredApprovals is the number of approvals, from distinct approvers, that a promotion requires.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// +optional
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+}
+
+// DeploymentSchedule defines when an Environment may be promoted to: within one of its windows (or at any time, if
+// there are none), and outside of all of its blackout periods.
+type DeploymentSchedule struct {
+	// TimeZone is the IANA name of the time zone the windows are expressed in.
+	// Example: Europe/Prague.
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Windows are the recurring periods during which promotions are allowed. If empty, promotions are allowed at any
+	// time outside of the blackout periods.
+	// Optional.
+	// +optional
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+
+	// Blackouts are the periods during which promotions are not allowed, for example change freezes. Blackouts take
+	// precedence over windows.
+	// Optional.
+	// +optional
+	Blackouts []BlackoutPeriod `json:"blackouts,omitempty"`
+}
+
+// DeploymentWindow is a recurring period of a day during which promotions are allowed, in the time zone of the
+// schedule.
+type DeploymentWindow struct {
+	// Days are the days of the week on which the window starts. If empty, the window starts every day.
+	// Optional.
+	// +optional
+	Days []Weekday `json:"days,omitempty"`
+
+	// Start is the time of the day at which the window starts, in the HH:MM format.
+	// Example: 09:00.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+
+	// End is the time of the day at which the window ends (exclusive), in the HH:MM format. If End is not after
+	// Start, the window ends on the following day.
+	// Example: 17:00.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	End string `json:"end"`
+}
+
+// Weekday is a day of the week.
+// +kubebuilder:validation:Enum=Mon;Tue;Wed;Thu;Fri;Sat;Sun
+type Weekday string
+
+const (
+	Weekday_Monday    Weekday = "Mon"
+	Weekday_Tuesday   Weekday = "Tue"
+	Weekday_Wednesday Weekday = "Wed"
+	Weekday_Thursday  Weekday = "Thu"
+	Weekday_Friday    Weekday = "Fri"
+	Weekday_Saturday  Weekday = "Sat"
+	Weekday_Sunday    Weekday = "Sun"
+)
+
+// BlackoutPeriod is a period during which promotions are not allowed.
+type BlackoutPeriod struct {
+	// Name is a user-visible description of the blackout period.
+	// Example: end-of-year freeze.
+	// Optional.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is the time at which the blackout period starts.
+	Start metav1.Time `json:"start"`
+
+	// End is the time at which the blackout period ends (exclusive).
+	End metav1.Time `json:"end"`
+}
+
+// DeploymentStrategyType defines the available promotion/deployment strategies for an Environment
+// See Environment API doc for details.
+type DeploymentStrategyType string
+
+const (
+	// DeploymentStrategy_Manual: Promotions to an Environment with this strategy will occur due to explicit user intent
+	DeploymentStrategy_Manual DeploymentStrategyType = "Manual"
+
+	// DeploymentStrategy_AppStudioAutomated: Promotions to an Environment with this strategy will occur if a previous ("parent")
+	// environment in the environment graph was successfully promoted to.
+	// See Environment API doc for details.
+	DeploymentStrategy_AppStudioAutomated DeploymentStrategyType = "AppStudioAutomated"
+)
+
+// ClusterConfiguration contains fields that are related to configuration of the target cluster of an Environment:
+// - credentials for connecting to the cluster
+type ClusterConfiguration struct {
+	// ClusterType indicates whether the target environment is Kubernetes or OpenShift
+	ClusterType ConfigurationClusterType `json:"clusterType,omitempty"`
+
+	// KubernetesClusterCredentials contains cluster credentials for a target Kubernetes/OpenShift cluster.
+	KubernetesClusterCredentials KubernetesClusterCredentials `json:"kubernetesCredentials,omitempty"`
+}
+
+type ConfigurationClusterType string
+
+const (
+	// ConfigurationClusterType_Kubernetes indicates the target environment is generic Kubernetes
+	ConfigurationClusterType_Kubernetes ConfigurationClusterType = "Kubernetes"
+
+	// ConfigurationClusterType_OpenShift indicates the target environment is OpenShift
+	ConfigurationClusterType_OpenShift ConfigurationClusterType = "OpenShift"
+)
+
+// KubernetesClusterCredentials contains cluster credentials for a target Kubernetes/OpenShift cluster.
+type KubernetesClusterCredentials struct {
+
+	// TargetNamespace is the default destination target on the cluster for deployments. This Namespace will be used
+	// for any GitOps repository K8s resources where the `.metadata.Namespace` field is not specified.
+	TargetNamespace string `json:"targetNamespace"`
+
+	// APIURL is a reference to a cluster API url defined within the kube config file of the cluster credentials secret.
+	APIURL string `json:"apiURL"`
+
+	// IngressDomain is the cluster's ingress domain.
+	// For example, in minikube it would be $(minikube ip).nip.io and in OCP it would look like apps.xyz.rhcloud.com.
+	// If clusterType == "Kubernetes", ingressDomain is mandatory and is enforced by the webhook validation
+	IngressDomain string `json:"ingressDomain,omitempty"`
+
+	// ClusterCredentialsSecret is a reference to the name of k8s Secret, defined within the same namespace as the Environment resource,
+	// that contains a kubeconfig.
+	// The Secret must be of type 'managed-gitops.redhat.com/managed-environment'
+	ClusterCredentialsSecret string `json:"clusterCredentialsSecret"`
+
+	// Indicates that ArgoCD/GitOps Service should not check the TLS certificate.
+	AllowInsecureSkipTLSVerify bool `json:"allowInsecureSkipTLSVerify"`
+
+	// Namespaces allows one to indicate which Namespaces the Secret's ServiceAccount has access to.
+	//
+	// Optional, defaults to empty. If empty, it is assumed that the ServiceAccount has access to all Namespaces.
+	//
+	// The ServiceAccount that GitOps Service/Argo CD uses to deploy may not have access to all of the Namespaces on a cluster.
+	// If not specified, it is assumed that the Argo CD ServiceAccount has read/write at cluster-scope.
+	// - If you are familiar with Argo CD: this field is equivalent to the field of the same name in the Argo CD Cluster Secret.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ClusterResources is used in conjuction with the Namespace field.
+	// If the Namespaces field is non-empty, this field will be used to determine whether Argo CD should
+	// attempt to manage cluster-scoped resources.
+	// - If Namespaces field is empty, this field is not used.
+	// - If you are familiar with Argo CD: this field is equivalent to the field of the same name in the Argo CD Cluster Secret.
+	//
+	// Optional, default to false.
+	ClusterResources bool `json:"clusterResources,omitempty"`
+}
+
+// EnvironmentConfiguration contains Environment-specific configurations details, to be used when generating
+// Component/Application GitOps repository resources.
+type EnvironmentConfiguration struct {
+	// Env is an array of standard environment vairables
+	Env []EnvVarPair `json:"env,omitempty"`
+
+	// Target is used to reference a DeploymentTargetClaim for a target Environment.
+	// The Environment controller uses the referenced DeploymentTargetClaim to access its bounded
+	// DeploymentTarget with cluster credential secret.
+	Target EnvironmentTarget `json:"target,omitempty"`
+
+	// RolloutStrategy describes how new versions of the components are rolled out within the Environment. It can be
+	// overridden per component by the SnapshotEnvironmentBinding.
+	// Optional: the components are rolled out with a rolling update if not set.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+}
+
+// EnvVarPair describes environment variables to use for the component
+type EnvVarPair struct {
+
+	// Name is the environment variable name
+	Name string `json:"name"`
+
+	// Value is the environment variable value
+	Value string `json:"value"`
+}
+
+// EnvironmentTarget provides the configuration for a deployment target.
+type EnvironmentTarget struct {
+	DeploymentTargetClaim DeploymentTargetClaimConfig `json:"deploymentTargetClaim"`
+}
+
+// DeploymentTargetClaimConfig specifies the DeploymentTargetClaim details for a given Environment.
+type DeploymentTargetClaimConfig struct {
+	ClaimName string `json:"claimName"`
+}
+
+// EnvironmentStatus defines the observed state of Environment
+type EnvironmentStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Environment is the Schema for the environments API
+// +kubebuilder:resource:path=environments,shortName=env
+type Environment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentSpec   `json:"spec,omitempty"`
+	Status EnvironmentStatus `json:"status,omitempty"`
+}
+
+// GetDeploymentTargetClaimName returns the name of the DeploymentTargetClaim
+// associated with this Environment
+func (e *Environment) GetDeploymentTargetClaimName() string {
+	return e.Spec.Configuration.Target.DeploymentTargetClaim.ClaimName
+}
+
+//+kubebuilder:object:root=true
+
+// EnvironmentList contains a list of Environment
+type EnvironmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Environment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Environment{}, &EnvironmentList{})
+}
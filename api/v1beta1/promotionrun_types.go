@@ -0,0 +1,353 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PromotionRunSpec defines the desired state of PromotionRun
+// +kubebuilder:validation:XValidation:rule="[has(self.manualPromotion), has(self.automatedPromotion), has(self.rollback)].filter(x, x).size() == 1",message="exactly one of 'manualPromotion', 'automatedPromotion' or 'rollback' must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.rollback) != has(self.snapshot)",message="'snapshot' must be specified for a promotion, and must not be specified for a rollback"
+type PromotionRunSpec struct {
+
+	// Snapshot refers to the name of a Snapshot resource defined within the namespace, used to promote container images between Environments.
+	// Required for a manual or automated promotion; a rollback specifies the Snapshot to roll back to within 'rollback' instead.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	Snapshot string `json:"snapshot,omitempty"`
+
+	// Application is the name of an Application resource defined within the namespaced, and which is the target of the promotion
+	Application string `json:"application"`
+
+	// ManualPromotion is for fields specific to manual promotion.
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	ManualPromotion *ManualPromotionConfiguration `json:"manualPromotion,omitempty"`
+
+	// AutomatedPromotion is for fields specific to automated promotion
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	AutomatedPromotion *AutomatedPromotionConfiguration `json:"automatedPromotion,omitempty"`
+
+	// Rollback is for fields specific to a rollback: the deployment of an Environment is reverted to a previously
+	// deployed Snapshot.
+	// Exactly one field must be defined: either 'manualPromotion', 'automatedPromotion' or 'rollback'.
+	// +optional
+	Rollback *RollbackConfiguration `json:"rollback,omitempty"`
+
+	// Timeout is the maximum duration of the whole promotion, measured from its start: once exceeded, the promotion
+	// completes with the 'TimedOut' result. If not set, the promotion does not time out.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// EnvironmentTimeout is the maximum duration of the promotion to a single environment, measured from the start of
+	// its environment status step: once exceeded, the step fails. If not set, the steps do not time out.
+	// +optional
+	EnvironmentTimeout *metav1.Duration `json:"environmentTimeout,omitempty"`
+
+	// RetryPolicy defines how many times the promotion to an environment is retried once its step fails. If not set,
+	// failed steps are not retried.
+	// +optional
+	RetryPolicy *PromotionRunRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Cancel requests the cancellation of the promotion: the promotion completes with the 'Cancelled' result, and the
+	// environments promoted to so far are left as they are. This is the only field of the spec which may be updated,
+	// and a cancelled promotion cannot be resumed.
+	// +optional
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// PromotionRunRetryPolicy defines how failed environment status steps are retried.
+type PromotionRunRetryPolicy struct {
+	// MaxRetries is the maximum number of times the promotion to a single environment is retried after a failure.
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries int `json:"maxRetries"`
+
+	// Backoff is the duration to wait before retrying a failed step. If not set, the step is retried immediately.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+}
+
+// PromotionType describes the type of promotion performed by a PromotionRun.
+type PromotionType string
+
+const (
+	ManualPromotionType    PromotionType = "Manual"
+	AutomatedPromotionType PromotionType = "Automated"
+	RollbackPromotionType  PromotionType = "Rollback"
+)
+
+// PromotionType returns the type of promotion that is set on the spec.
+// An error is returned if either no promotion, or more than one promotion, is set.
+func (s *PromotionRunSpec) PromotionType() (PromotionType, error) {
+	var promotionType PromotionType
+	set := 0
+	if s.ManualPromotion != nil {
+		promotionType = ManualPromotionType
+		set++
+	}
+	if s.AutomatedPromotion != nil {
+		promotionType = AutomatedPromotionType
+		set++
+	}
+	if s.Rollback != nil {
+		promotionType = RollbackPromotionType
+		set++
+	}
+
+	switch set {
+	case 0:
+		return "", errors.New("either a manual promotion, an automated promotion or a rollback must be specified")
+	case 1:
+		return promotionType, nil
+	default:
+		return "", errors.New("only one of a manual promotion, an automated promotion or a rollback may be specified")
+	}
+}
+
+// ManualPromotionConfiguration defines promotion parameters specific to manual promotion: the target environment to promote to.
+type ManualPromotionConfiguration struct {
+	// TargetEnvironment is the environment to promote to
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+}
+
+// AutomatedPromotionConfiguration defines promotion parameters specific to automated promotion: the initial environment
+// (in the promotion graph) to begin promoting on.
+type AutomatedPromotionConfiguration struct {
+	// InitialEnvironment: start iterating through the digraph, beginning with the value specified in 'initialEnvironment'
+	// +kubebuilder:validation:MinLength=1
+	InitialEnvironment string `json:"initialEnvironment"`
+}
+
+// RollbackToPreviousSnapshot is the value of RollbackConfiguration's ToSnapshot field which rolls an Environment back
+// to the Snapshot that was deployed to it before the current one.
+const RollbackToPreviousSnapshot = "previous"
+
+// RollbackConfiguration defines parameters specific to a rollback: the environment to roll back, and the Snapshot to
+// roll it back to.
+type RollbackConfiguration struct {
+	// TargetEnvironment is the environment to roll back
+	// +kubebuilder:validation:MinLength=1
+	TargetEnvironment string `json:"targetEnvironment"`
+
+	// ToSnapshot is the name of a Snapshot resource defined within the namespace to roll back to, or 'previous' to roll
+	// back to the Snapshot that was deployed to the environment before the current one.
+	// +kubebuilder:default=previous
+	// +optional
+	ToSnapshot string `json:"toSnapshot,omitempty"`
+}
+
+// PromotionRunRollbackStatus records the Snapshots involved in a rollback.
+type PromotionRunRollbackStatus struct {
+	// Snapshot is the name of the Snapshot the environment was rolled back to, with 'previous' resolved to the
+	// actual Snapshot.
+	Snapshot string `json:"snapshot"`
+
+	// ReplacedSnapshot is the name of the Snapshot that was deployed to the environment before the rollback.
+	ReplacedSnapshot string `json:"replacedSnapshot"`
+}
+
+// PromotionRunStatus defines the observed state of PromotionRun
+type PromotionRunStatus struct {
+
+	// State indicates whether or not the overall promotion (either manual or automated is complete)
+	State PromotionRunState `json:"state"`
+
+	// CompletionResult indicates success/failure once the promotion has completed all work.
+	// CompletionResult will only have a value if State field is 'Complete'.
+	CompletionResult PromotionRunCompleteResult `json:"completionResult,omitempty"`
+
+	// EnvironmentStatus represents the set of steps taken during the  current promotion
+	EnvironmentStatus []PromotionRunEnvironmentStatus `json:"environmentStatus,omitempty"`
+
+	// ActiveBindings is the list of active bindings currently being promoted to:
+	// - For an automated promotion, there can be multiple active bindings at a time (one for each env at a particular tree depth)
+	// - For a manual promotion, there will be only one.
+	ActiveBindings []string `json:"activeBindings,omitempty"`
+
+	// PromotionStartTime is set to the value when the PromotionRun Reconciler first started the promotion.
+	PromotionStartTime metav1.Time `json:"promotionStartTime,omitempty"`
+
+	// Rollback records the Snapshots involved in a rollback, once the Snapshot to roll back to has been resolved.
+	// Only set for a rollback.
+	// +optional
+	Rollback *PromotionRunRollbackStatus `json:"rollback,omitempty"`
+
+	// Approvals records the approvals of the environments which require an approval before being promoted to, one
+	// entry per environment. The promotion is in the 'Waiting' state while an approval is pending.
+	// +optional
+	// +listType=map
+	// +listMapKey=environmentName
+	Approvals []PromotionRunEnvironmentApproval `json:"approvals,omitempty"`
+
+	// Conditions is an array of the PromotionRun's status conditions
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PromotionRunEnvironmentApproval records the approval of the promotion to a single environment.
+type PromotionRunEnvironmentApproval struct {
+	// EnvironmentName is the name of the environment which requires the approval
+	EnvironmentName string `json:"environmentName"`
+
+	// State is the state of the approval
+	State ApprovalState `json:"state"`
+
+	// RequiredApprovals is the number of approvals required by the approval policy of the environment
+	RequiredApprovals int `json:"requiredApprovals"`
+
+	// Decisions are the decisions of the approvers, in the order they were made
+	// +optional
+	Decisions []ApprovalDecision `json:"decisions,omitempty"`
+}
+
+// ApprovalDecision records the decision of a single approver.
+type ApprovalDecision struct {
+	// Approver is the name of the user who made the decision
+	Approver string `json:"approver"`
+
+	// Decision is whether the approver approved or rejected the promotion
+	Decision ApprovalDecisionType `json:"decision"`
+
+	// Comment is an optional comment of the approver
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Time is the time the decision was made
+	Time metav1.Time `json:"time"`
+}
+
+// ApprovalState is the state of the approval of the promotion to an environment.
+// +kubebuilder:validation:Enum=Pending;Approved;Rejected
+type ApprovalState string
+
+const (
+	// ApprovalState_Pending: the environment has not received the required number of approvals yet
+	ApprovalState_Pending ApprovalState = "Pending"
+	// ApprovalState_Approved: the environment has received the required number of approvals
+	ApprovalState_Approved ApprovalState = "Approved"
+	// ApprovalState_Rejected: an approver rejected the promotion to the environment
+	ApprovalState_Rejected ApprovalState = "Rejected"
+)
+
+// ApprovalDecisionType is the decision of an approver.
+// +kubebuilder:validation:Enum=Approved;Rejected
+type ApprovalDecisionType string
+
+const (
+	ApprovalDecision_Approved ApprovalDecisionType = "Approved"
+	ApprovalDecision_Rejected ApprovalDecisionType = "Rejected"
+)
+
+// PromotionRunState defines the 3 states of an Promotion resource.
+type PromotionRunState string
+
+const (
+	PromotionRunState_Active   PromotionRunState = "Active"
+	PromotionRunState_Waiting  PromotionRunState = "Waiting"
+	PromotionRunState_Complete PromotionRunState = "Complete"
+)
+
+// PromotionRunCompleteResult defines the success/failure states if the PromotionRunState is 'Complete'.
+type PromotionRunCompleteResult string
+
+const (
+	PromotionRunCompleteResult_Success   PromotionRunCompleteResult = "Success"
+	PromotionRunCompleteResult_Failure   PromotionRunCompleteResult = "Failure"
+	PromotionRunCompleteResult_TimedOut  PromotionRunCompleteResult = "TimedOut"
+	PromotionRunCompleteResult_Cancelled PromotionRunCompleteResult = "Cancelled"
+)
+
+// PromotionRunEnvironmentStatus represents the set of steps taken during the  current promotion:
+// - manual promotions will only have a single step.
+// - automated promotions may have one or more steps, depending on how many environments have been promoted to.
+type PromotionRunEnvironmentStatus struct {
+
+	// Step is the sequential number of the step in the array, starting with 1
+	Step int `json:"step"`
+
+	// EnvironmentName is the name of the environment that was promoted to in this step
+	EnvironmentName string `json:"environmentName"`
+
+	// Status is/was the result of promoting to that environment. It is 'Waiting For Approval' while the promotion to an
+	// environment which requires an approval is waiting for it to be granted.
+	Status PromotionRunEnvironmentStatusField `json:"status"`
+
+	// DisplayStatus is human-readible description of the current state/status.
+	DisplayStatus string `json:"displayStatus"`
+
+	// StartTime is the time the promotion to the environment started in this step, used to enforce the
+	// environment timeout.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+}
+
+// PromotionRunEnvironmentStatusField are the state values for promotion to individual enviroments, as
+// used by the Status field of PromotionRunEnvironmentStatus
+type PromotionRunEnvironmentStatusField string
+
+const (
+	PromotionRunEnvironmentStatus_Success    PromotionRunEnvironmentStatusField = "Success"
+	PromotionRunEnvironmentStatus_InProgress PromotionRunEnvironmentStatusField = "In Progress"
+	PromotionRunEnvironmentStatus_WaitingForApproval PromotionRunEnvironmentStatusField = "Waiting For Approval"
+	PromotionRunEnvironmentStatus_Failed     PromotionRunEnvironmentStatusField = "Failed"
+)
+
+// Constants used with PromotionRunStatus's Conditions field
+const (
+	PromotionRunConditionErrorOccurred = "ErrorOccurred"
+	// PromotionRunConditionCompleted is True once the promotion is complete, with a reason describing its result
+	PromotionRunConditionCompleted = "Completed"
+
+	PromotionRunReasonErrorOccurred = "ErrorOccurred"
+	PromotionRunReasonSucceeded     = "Succeeded"
+	PromotionRunReasonFailed        = "Failed"
+	PromotionRunReasonTimedOut      = "TimedOut"
+	PromotionRunReasonCancelled     = "Cancelled"
+	// PromotionRunReasonRetriesExhausted is used when the promotion to an environment failed after all retries
+	PromotionRunReasonRetriesExhausted = "RetriesExhausted"
+)
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// PromotionRun is the Schema for the promotionruns API
+// +kubebuilder:resource:path=promotionruns,shortName=apr;promotion
+type PromotionRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PromotionRunSpec   `json:"spec,omitempty"`
+	Status PromotionRunStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// PromotionRunList contains a list of PromotionRun
+type PromotionRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PromotionRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PromotionRun{}, &PromotionRunList{})
+}
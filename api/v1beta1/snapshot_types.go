@@ -0,0 +1,172 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotSpec defines the desired state of Snapshot
+type SnapshotSpec struct {
+
+	// Application is a reference to the name of an Application resource within the same namespace, which defines the target application for the Snapshot (when used with a Binding).
+	Application string `json:"application"`
+
+	// DisplayName is a user-visible, user-definable name for the resource (and is not used for any functional behaviour)
+	DisplayName string `json:"displayName,omitempty"`
+
+	// DisplayDescription is a user-visible, user definable description for the resource (and is not used for any functional behaviour)
+	DisplayDescription string `json:"displayDescription,omitempty"`
+
+	// Components field contains the sets of components to deploy as part of this snapshot.
+	Components []SnapshotComponent `json:"components,omitempty"`
+
+	// Artifacts contains the 'artifact links' we want to maintain to other AppStudio resources.
+	Artifacts SnapshotArtifacts `json:"artifacts,omitempty"`
+}
+
+// SnapshotComponent
+type SnapshotComponent struct {
+
+	// Name is the name of the component
+	Name string `json:"name"`
+
+	// ContainerImage is the container image to use when deploying the component, as part of a Snapshot
+	ContainerImage string `json:"containerImage"`
+
+	// Source describes the Component source.
+	// Optional.
+	// +optional
+	Source ComponentSource `json:"source,omitempty"`
+}
+
+// SnapshotArtifacts contains the 'artifact links' we want to maintain to other AppStudio resources.
+//
+// For example: the container image <=> (source code repo, commit sha) links of each component, which are used
+// by release tooling and might be useful to present to the user within the UI.
+type SnapshotArtifacts struct {
+
+	// Components contains the artifacts of the components of the Snapshot, one entry per component.
+	// Optional.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Components []SnapshotComponentArtifacts `json:"components,omitempty"`
+}
+
+// SnapshotComponentArtifacts contains the artifacts of a single component of a Snapshot.
+type SnapshotComponentArtifacts struct {
+
+	// Name is the name of the component, matching the name of an entry in the Snapshot's components.
+	// Required.
+	// +required
+	Name string `json:"name"`
+
+	// Source is the source code revision the component's container image was built from.
+	// Optional.
+	// +optional
+	Source *SnapshotArtifactSource `json:"source,omitempty"`
+
+	// ImageDigest is the digest of the component's container image.
+	// Example: sha256:1b2dd8b2d2d8b5e1ab8a3e7f0ee0c5b1dd3d31ac3b1b6c16b2d0c5ae1c1a8c4d.
+	// Optional.
+	// +kubebuilder:validation:Pattern=`^sha256:[a-f0-9]{64}$`
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// SBOM is a reference to the Software Bill of Materials of the component's container image, for example an
+	// OCI artifact reference or a URL.
+	// Optional.
+	// +optional
+	SBOM string `json:"sbom,omitempty"`
+
+	// Signatures are references to the signatures of the component's container image, for example OCI artifact references.
+	// Optional.
+	// +optional
+	Signatures []string `json:"signatures,omitempty"`
+
+	// Attestations are references to the attestations of the component's container image, for example the
+	// build provenance, as OCI artifact references.
+	// Optional.
+	// +optional
+	Attestations []string `json:"attestations,omitempty"`
+
+	// BuildPipelineRun is the name of the PipelineRun, defined within the same namespace, which built the component's container image.
+	// Optional.
+	// +optional
+	BuildPipelineRun string `json:"buildPipelineRun,omitempty"`
+}
+
+// SnapshotArtifactSource describes the source code revision a component's container image was built from.
+type SnapshotArtifactSource struct {
+
+	// RepositoryURL is the URL of the git repository.
+	// Required.
+	// +required
+	RepositoryURL string `json:"repositoryURL"`
+
+	// CommitSHA is the commit id (SHA-1 checksum) the container image was built from.
+	// Example: 41fbdb124775323f58fd5ce93c70bb7d79c20650.
+	// Required.
+	// +required
+	CommitSHA string `json:"commitSHA"`
+}
+
+// SnapshotStatus defines the observed state of Snapshot
+type SnapshotStatus struct {
+	// Conditions represent the latest available observations for the Snapshot
+	// +optional
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Snapshot is the Schema for the snapshots API
+// +kubebuilder:resource:path=snapshots,shortName=as;snapshot
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec,omitempty"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// GetComponentArtifacts returns the artifacts of the component with the given name, or nil
+// if the Snapshot has no artifacts for that component.
+func (s *Snapshot) GetComponentArtifacts(componentName string) *SnapshotComponentArtifacts {
+	for i := range s.Spec.Artifacts.Components {
+		if s.Spec.Artifacts.Components[i].Name == componentName {
+			return &s.Spec.Artifacts.Components[i]
+		}
+	}
+	return nil
+}
+
+//+kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+}
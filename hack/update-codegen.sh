@@ -0,0 +1,69 @@
+#!/bin/sh
+
+# Generates the typed clientset, listers and informers for the appstudio.redhat.com API group under pkg/client.
+# The client-gen, lister-gen and informer-gen binaries (from k8s.io/code-generator) are expected in ./bin, and can be
+# installed with 'make code-generator'.
+
+set -e
+
+THIS_DIR="$(dirname "$(realpath "$0")")"
+ROOT_DIR="$(realpath ${THIS_DIR}/..)"
+BIN_DIR="${ROOT_DIR}/bin"
+HEADER_FILE="${THIS_DIR}/boilerplate.go.txt"
+
+MODULE="github.com/redhat-appstudio/application-api"
+OUTPUT_PKG="${MODULE}/pkg/client"
+GROUP="appstudio"
+VERSIONS="v1alpha1 v1beta1"
+
+# The code generators derive the group of an API package from its parent directory, and treat a directory named 'api'
+# as the legacy core group. So the API packages are copied to a 'apis/<group>/<version>' layout within a temporary
+# copy of the module, and the import paths of the generated code are rewritten afterwards.
+WORK_DIR="$(mktemp -d)"
+trap 'rm -rf "${WORK_DIR}"' EXIT
+
+MODULE_DIR="${WORK_DIR}/module"
+OUTPUT_BASE="${WORK_DIR}/output"
+mkdir -p "${MODULE_DIR}" "${OUTPUT_BASE}"
+cp "${ROOT_DIR}/go.mod" "${ROOT_DIR}/go.sum" "${MODULE_DIR}"
+# the API packages may import each other (e.g. for conversion), so the original layout is kept as well
+cp -r "${ROOT_DIR}/api" "${MODULE_DIR}/api"
+
+INPUT_DIRS=""
+for VERSION in ${VERSIONS}; do
+  mkdir -p "${MODULE_DIR}/apis/${GROUP}"
+  cp -r "${ROOT_DIR}/api/${VERSION}" "${MODULE_DIR}/apis/${GROUP}/${VERSION}"
+  INPUT_DIRS="${INPUT_DIRS:+${INPUT_DIRS},}${MODULE}/apis/${GROUP}/${VERSION}"
+done
+
+cd "${MODULE_DIR}"
+
+echo "Generating clientset at ${OUTPUT_PKG}/clientset"
+"${BIN_DIR}/client-gen" \
+  --clientset-name versioned \
+  --input-base "" \
+  --input "${INPUT_DIRS}" \
+  --output-package "${OUTPUT_PKG}/clientset" \
+  --output-base "${OUTPUT_BASE}" \
+  --go-header-file "${HEADER_FILE}"
+
+echo "Generating listers at ${OUTPUT_PKG}/listers"
+"${BIN_DIR}/lister-gen" \
+  --input-dirs "${INPUT_DIRS}" \
+  --output-package "${OUTPUT_PKG}/listers" \
+  --output-base "${OUTPUT_BASE}" \
+  --go-header-file "${HEADER_FILE}"
+
+echo "Generating informers at ${OUTPUT_PKG}/informers"
+"${BIN_DIR}/informer-gen" \
+  --input-dirs "${INPUT_DIRS}" \
+  --versioned-clientset-package "${OUTPUT_PKG}/clientset/versioned" \
+  --listers-package "${OUTPUT_PKG}/listers" \
+  --output-package "${OUTPUT_PKG}/informers" \
+  --output-base "${OUTPUT_BASE}" \
+  --go-header-file "${HEADER_FILE}"
+
+find "${OUTPUT_BASE}" -name '*.go' -exec sed -i "s#${MODULE}/apis/${GROUP}/#${MODULE}/api/#g" {} +
+
+rm -rf "${ROOT_DIR}/pkg/client"
+cp -r "${OUTPUT_BASE}/${OUTPUT_PKG}" "${ROOT_DIR}/pkg/client"
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package binder implements the protocol used to bind a DeploymentTargetClaim (DTC) to a DeploymentTarget (DT).
+//
+// A DTC is bound to a DT when DTC.spec.targetName references the DT and DT.spec.claimRef references the DTC. A DTC may
+// request a specific DT via spec.targetName, and a DT may be pre-bound to a DTC via spec.claimRef. Otherwise, the
+// binder selects an Available DT of the same DeploymentTargetClass that is not claimed yet, and annotates the DTC with
+// AnnBoundByController. A DTC annotated with AnnTargetProvisioner is never matched with an existing DT this way: it
+// waits for its provisioner to create a DT pre-bound to it. Once both objects reference each other, the DTC is
+// annotated with AnnBindCompleted.
+//
+// The functions of this package do not require a cluster: they only operate on the objects they are given, so that
+// independent binder implementations behave identically.
+package binder
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// MatchResult is the outcome of matching a DeploymentTargetClaim against a set of DeploymentTargets.
+type MatchResult struct {
+	// Target is the DeploymentTarget the claim should be bound to, or nil if there is no compatible target.
+	Target *appstudiov1alpha1.DeploymentTarget
+
+	// BoundByController is true if the target was selected by the binder, rather than explicitly requested by the
+	// claim's spec.targetName or the target's spec.claimRef.
+	BoundByController bool
+
+	// ClaimPhase is the next phase of the claim.
+	ClaimPhase appstudiov1alpha1.DeploymentTargetClaimPhase
+
+	// TargetPhase is the next phase of the target. It is empty if Target is nil.
+	TargetPhase appstudiov1alpha1.DeploymentTargetPhase
+}
+
+// Match selects the DeploymentTarget, from targets, that the given claim should be bound to. Targets are considered in
+// the following order:
+//   - if the claim's spec.targetName is set, the target with that name
+//   - a target whose spec.claimRef references the claim
+//   - an Available target of the same class, which is not claimed yet (the first in name order), unless the claim is
+//     dynamically provisioned, in which case it remains Pending until its provisioner creates a target for it
+//
+// An error is returned if the target explicitly requested by the claim is not compatible with it. If the claim was
+// previously bound, but its target no longer exists, the claim phase of the result is Lost.
+func Match(claim *appstudiov1alpha1.DeploymentTargetClaim, targets []appstudiov1alpha1.DeploymentTarget) (MatchResult, error) {
+	if claim.Spec.TargetName != "" {
+		target := findTarget(targets, func(dt *appstudiov1alpha1.DeploymentTarget) bool { return dt.Name == claim.Spec.TargetName })
+		if target == nil {
+			if IsBindCompleted(claim) {
+				return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Lost}, nil
+			}
+			return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Pending}, nil
+		}
+		if err := CheckCompatible(claim, target); err != nil {
+			return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Pending}, err
+		}
+		return boundResult(target, IsBoundByController(claim)), nil
+	}
+
+	if target := findTarget(targets, func(dt *appstudiov1alpha1.DeploymentTarget) bool { return dt.Spec.ClaimRef == claim.Name }); target != nil {
+		if err := CheckCompatible(claim, target); err != nil {
+			return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Pending}, err
+		}
+		return boundResult(target, false), nil
+	}
+
+	if _, dynamic := IsDynamicallyProvisioned(claim); dynamic {
+		return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Pending}, nil
+	}
+	target := findTarget(targets, func(dt *appstudiov1alpha1.DeploymentTarget) bool {
+		return dt.Status.Phase == appstudiov1alpha1.DeploymentTargetPhase_Available && CheckCompatible(claim, dt) == nil
+	})
+	if target == nil {
+		return MatchResult{ClaimPhase: appstudiov1alpha1.DeploymentTargetClaimPhase_Pending}, nil
+	}
+	return boundResult(target, true), nil
+}
+
+// CheckCompatible returns an error if the given target cannot be bound to the given claim: the target must be of the same
+// DeploymentTargetClass, must not be claimed by another claim, and the claim must not request another target.
+func CheckCompatible(claim *appstudiov1alpha1.DeploymentTargetClaim, target *appstudiov1alpha1.DeploymentTarget) error {
+	if claim.Spec.DeploymentTargetClassName != target.Spec.DeploymentTargetClassName {
+		return fmt.Errorf("deployment target %q has class %q, but claim %q requests class %q", target.Name,
+			target.Spec.DeploymentTargetClassName, claim.Name, claim.Spec.DeploymentTargetClassName)
+	}
+	if target.Spec.ClaimRef != "" && target.Spec.ClaimRef != claim.Name {
+		return fmt.Errorf("deployment target %q is already claimed by %q", target.Name, target.Spec.ClaimRef)
+	}
+	if claim.Spec.TargetName != "" && claim.Spec.TargetName != target.Name {
+		return fmt.Errorf("claim %q requests deployment target %q, not %q", claim.Name, claim.Spec.TargetName, target.Name)
+	}
+	switch target.Status.Phase {
+	case appstudiov1alpha1.DeploymentTargetPhase_Released, appstudiov1alpha1.DeploymentTargetPhase_Failed:
+		return fmt.Errorf("deployment target %q cannot be bound in phase %q", target.Name, target.Status.Phase)
+	}
+	return nil
+}
+
+// Bind updates the given claim and target so that they reference each other, and sets their phases and the binder
+// annotations accordingly. boundByController should be set from the MatchResult the target was selected by.
+func Bind(claim *appstudiov1alpha1.DeploymentTargetClaim, target *appstudiov1alpha1.DeploymentTarget, boundByController bool) {
+	claim.Spec.TargetName = target.Name
+	target.Spec.ClaimRef = claim.Name
+
+	if claim.Annotations == nil {
+		claim.Annotations = map[string]string{}
+	}
+	claim.Annotations[appstudiov1alpha1.AnnBindCompleted] = appstudiov1alpha1.AnnBinderValueTrue
+	if boundByController {
+		claim.Annotations[appstudiov1alpha1.AnnBoundByController] = appstudiov1alpha1.AnnBinderValueTrue
+	}
+
+	claim.Status.Phase = appstudiov1alpha1.DeploymentTargetClaimPhase_Bound
+	target.Status.Phase = appstudiov1alpha1.DeploymentTargetPhase_Bound
+}
+
+// NextTargetPhase returns the next phase of the given target. claim is the DeploymentTargetClaim referenced by the
+// target's spec.claimRef, or nil if the target is not claimed, or if the claim no longer exists. A target whose
+// spec.claimRef is set is Bound, even before the claim references it, so that it is not offered to other claims.
+func NextTargetPhase(target *appstudiov1alpha1.DeploymentTarget, claim *appstudiov1alpha1.DeploymentTargetClaim) appstudiov1alpha1.DeploymentTargetPhase {
+	switch {
+	case target.Status.Phase == appstudiov1alpha1.DeploymentTargetPhase_Failed:
+		// a failure to release external resources can only be resolved by the provisioner
+		return appstudiov1alpha1.DeploymentTargetPhase_Failed
+
+	case target.Spec.ClaimRef != "" && claim == nil:
+		// the claim was deleted after the target was bound to it
+		return appstudiov1alpha1.DeploymentTargetPhase_Released
+
+	case target.Spec.ClaimRef != "":
+		return appstudiov1alpha1.DeploymentTargetPhase_Bound
+
+	case target.Status.Phase == appstudiov1alpha1.DeploymentTargetPhase_Pending || target.Status.Phase == "":
+		return appstudiov1alpha1.DeploymentTargetPhase_Pending
+
+	default:
+		return appstudiov1alpha1.DeploymentTargetPhase_Available
+	}
+}
+
+// IsBindCompleted returns true if the binding controller completed the binding process of the given claim.
+func IsBindCompleted(claim *appstudiov1alpha1.DeploymentTargetClaim) bool {
+	return claim.Annotations[appstudiov1alpha1.AnnBindCompleted] == appstudiov1alpha1.AnnBinderValueTrue
+}
+
+// IsBoundByController returns true if the target of the given claim was selected by the binding controller.
+func IsBoundByController(claim *appstudiov1alpha1.DeploymentTargetClaim) bool {
+	return claim.Annotations[appstudiov1alpha1.AnnBoundByController] == appstudiov1alpha1.AnnBinderValueTrue
+}
+
+// IsDynamicallyProvisioned returns true if a target should be dynamically provisioned for the given claim, and returns
+// the name of the provisioner.
+func IsDynamicallyProvisioned(claim *appstudiov1alpha1.DeploymentTargetClaim) (string, bool) {
+	provisioner, exists := claim.Annotations[appstudiov1alpha1.AnnTargetProvisioner]
+	return provisioner, exists && provisioner != ""
+}
+
+func boundResult(target *appstudiov1alpha1.DeploymentTarget, boundByController bool) MatchResult {
+	return MatchResult{
+		Target:            target,
+		BoundByController: boundByController,
+		ClaimPhase:        appstudiov1alpha1.DeploymentTargetClaimPhase_Bound,
+		TargetPhase:       appstudiov1alpha1.DeploymentTargetPhase_Bound,
+	}
+}
+
+// findTarget returns the first target, in name order, that matches the given function.
+func findTarget(targets []appstudiov1alpha1.DeploymentTarget, matches func(*appstudiov1alpha1.DeploymentTarget) bool) *appstudiov1alpha1.DeploymentTarget {
+	var found *appstudiov1alpha1.DeploymentTarget
+	for i := range targets {
+		dt := &targets[i]
+		if matches(dt) && (found == nil || dt.Name < found.Name) {
+			found = dt
+		}
+	}
+	return found
+}
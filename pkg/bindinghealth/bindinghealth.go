@@ -0,0 +1,216 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bindinghealth aggregates the sync and health status of the GitOpsDeployments of a SnapshotEnvironmentBinding
+// into the overall status of the binding.
+//
+// Each component of the binding is deployed by a GitOpsDeployment, whose sync and health status are reported in the
+// binding's status.gitopsDeployments. A component is deployed when its GitOpsDeployment is both Synced and Healthy.
+// The overall health of the binding is the worst health of its components, in the following order (from best to
+// worst): Healthy, Suspended, Progressing, Missing, Degraded, Unknown.
+//
+// The sync of a binding, or of some of its components, may also be suspended by the binding's spec; see
+// SetSuspensionStatus for the status reflecting it.
+package bindinghealth
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// healthOrder ranks the health status codes from best to worst.
+var healthOrder = map[appstudiov1alpha1.HealthStatusCode]int{
+	appstudiov1alpha1.HealthStatusCode_Healthy:     0,
+	appstudiov1alpha1.HealthStatusCode_Suspended:   1,
+	appstudiov1alpha1.HealthStatusCode_Progressing: 2,
+	appstudiov1alpha1.HealthStatusCode_Missing:     3,
+	appstudiov1alpha1.HealthStatusCode_Degraded:    4,
+	appstudiov1alpha1.HealthStatusCode_Unknown:     5,
+}
+
+// IsWorse returns true if the health status current is worse than the health status other.
+// Unset or unrecognized health status codes are considered Unknown.
+func IsWorse(current, other appstudiov1alpha1.HealthStatusCode) bool {
+	return healthOrder[normalizeHealth(current)] > healthOrder[normalizeHealth(other)]
+}
+
+// ComponentStatus is the sync and health status of a component of the binding.
+type ComponentStatus struct {
+	// Name is the name of the component
+	Name string
+
+	// GitOpsDeployment is the name of the GitOpsDeployment deploying the component, or empty if there is none yet
+	GitOpsDeployment string
+
+	// Sync is the sync status of the component, Unknown if it is not reported
+	Sync appstudiov1alpha1.SyncStatusCode
+
+	// Health is the health status of the component, Missing if it has no GitOpsDeployment yet, and Unknown if it is
+	// not reported
+	Health appstudiov1alpha1.HealthStatusCode
+
+	// Suspended is true if the sync of the component is suspended, see IsSuspended
+	Suspended bool
+}
+
+// IsDeployed returns true if the component is both Synced and Healthy.
+func (c ComponentStatus) IsDeployed() bool {
+	return c.Sync == appstudiov1alpha1.SyncStatusCode_Synced && c.Health == appstudiov1alpha1.HealthStatusCode_Healthy
+}
+
+// Summary is the aggregated status of the components of a binding.
+type Summary struct {
+	// Components is the status of each component, in the order of the binding's spec.components
+	Components []ComponentStatus
+
+	// Health is the worst health status of the components, Healthy if the binding has no components
+	Health appstudiov1alpha1.HealthStatusCode
+
+	// Sync is OutOfSync if any component is OutOfSync, Unknown if the sync status of any other component is Unknown,
+	// and Synced otherwise
+	Sync appstudiov1alpha1.SyncStatusCode
+
+	// Synced is the number of components which are Synced
+	Synced int
+
+	// Deployed is the number of components which are deployed
+	Deployed int
+}
+
+// AllDeployed returns true if all of the components are deployed.
+func (s *Summary) AllDeployed() bool {
+	return s.Deployed == len(s.Components)
+}
+
+// Aggregate returns the aggregated status of the components of the given binding. The components are those of the
+// binding's spec.components; if it has none, the components of its status.gitopsDeployments are used instead.
+func Aggregate(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) *Summary {
+	deployments := map[string]appstudiov1alpha1.BindingStatusGitOpsDeployment{}
+	for _, deployment := range binding.Status.GitOpsDeployments {
+		deployments[deployment.ComponentName] = deployment
+	}
+
+	var names []string
+	for _, component := range binding.Spec.Components {
+		names = append(names, component.Name)
+	}
+	if len(names) == 0 {
+		for _, deployment := range binding.Status.GitOpsDeployments {
+			names = append(names, deployment.ComponentName)
+		}
+	}
+
+	summary := &Summary{
+		Health: appstudiov1alpha1.HealthStatusCode_Healthy,
+		Sync:   appstudiov1alpha1.SyncStatusCode_Synced,
+	}
+	for _, name := range names {
+		status := ComponentStatus{
+			Name:      name,
+			Sync:      appstudiov1alpha1.SyncStatusCode_Unknown,
+			Health:    appstudiov1alpha1.HealthStatusCode_Missing,
+			Suspended: IsSuspended(binding, name),
+		}
+		if deployment, exists := deployments[name]; exists {
+			status.GitOpsDeployment = deployment.GitOpsDeployment
+			status.Sync = normalizeSync(deployment.GitOpsDeploymentSyncStatus)
+			status.Health = normalizeHealth(deployment.GitOpsDeploymentHealthStatus)
+		}
+		summary.Components = append(summary.Components, status)
+
+		if IsWorse(status.Health, summary.Health) {
+			summary.Health = status.Health
+		}
+		if status.Sync == appstudiov1alpha1.SyncStatusCode_OutOfSync ||
+			(status.Sync == appstudiov1alpha1.SyncStatusCode_Unknown && summary.Sync == appstudiov1alpha1.SyncStatusCode_Synced) {
+			summary.Sync = status.Sync
+		}
+		if status.Sync == appstudiov1alpha1.SyncStatusCode_Synced {
+			summary.Synced++
+		}
+		if status.IsDeployed() {
+			summary.Deployed++
+		}
+	}
+
+	return summary
+}
+
+// SetComponentDeploymentConditions aggregates the status of the components of the given binding, and sets the
+// conditions of its status.componentDeploymentConditions accordingly:
+//   - AllComponentsDeployed is True if all of the components are deployed, and False otherwise
+//   - CommitsSynced is True if all of the components are Synced, and False otherwise
+//   - CommitsUnsynced is the opposite of CommitsSynced
+//
+// The reason of each condition is CommitsSynced if all of the components are Synced, and CommitsUnsynced otherwise, so
+// that the conditions never contradict each other. Returns the aggregated status, and true if the conditions have
+// changed.
+func SetComponentDeploymentConditions(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) (*Summary, bool) {
+	summary := Aggregate(binding)
+
+	reason := appstudiov1alpha1.ComponentDeploymentReasonCommitsSynced
+	synced, unsynced := metav1.ConditionTrue, metav1.ConditionFalse
+	if summary.Sync != appstudiov1alpha1.SyncStatusCode_Synced {
+		reason = appstudiov1alpha1.ComponentDeploymentReasonCommitsUnsynced
+		synced, unsynced = metav1.ConditionFalse, metav1.ConditionTrue
+	}
+	deployed := metav1.ConditionTrue
+	if !summary.AllDeployed() {
+		deployed = metav1.ConditionFalse
+	}
+	syncedMessage := fmt.Sprintf("%d of %d components synced", summary.Synced, len(summary.Components))
+
+	deploymentConditions := &binding.Status.ComponentDeploymentConditions
+	changed := conditions.SetForObject(binding, deploymentConditions, metav1.Condition{
+		Type:    appstudiov1alpha1.ComponentDeploymentConditionAllComponentsDeployed,
+		Status:  deployed,
+		Reason:  reason,
+		Message: fmt.Sprintf("%d of %d components deployed", summary.Deployed, len(summary.Components)),
+	})
+	changed = conditions.SetForObject(binding, deploymentConditions, metav1.Condition{
+		Type:    appstudiov1alpha1.ComponentDeploymentConditionCommitsSynced,
+		Status:  synced,
+		Reason:  reason,
+		Message: syncedMessage,
+	}) || changed
+	changed = conditions.SetForObject(binding, deploymentConditions, metav1.Condition{
+		Type:    appstudiov1alpha1.ComponentDeploymentConditionCommitsUnsynced,
+		Status:  unsynced,
+		Reason:  reason,
+		Message: syncedMessage,
+	}) || changed
+
+	return summary, changed
+}
+
+func normalizeSync(sync appstudiov1alpha1.SyncStatusCode) appstudiov1alpha1.SyncStatusCode {
+	switch sync {
+	case appstudiov1alpha1.SyncStatusCode_Synced, appstudiov1alpha1.SyncStatusCode_OutOfSync:
+		return sync
+	default:
+		return appstudiov1alpha1.SyncStatusCode_Unknown
+	}
+}
+
+func normalizeHealth(health appstudiov1alpha1.HealthStatusCode) appstudiov1alpha1.HealthStatusCode {
+	if _, known := healthOrder[health]; known {
+		return health
+	}
+	return appstudiov1alpha1.HealthStatusCode_Unknown
+}
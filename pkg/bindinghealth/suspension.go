@@ -0,0 +1,117 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bindinghealth
+
+import (
+	"fmt"
+	"strings"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsSuspended returns true if the sync of the given component of the binding is suspended, either because the whole
+// binding is suspended, or because the component is.
+func IsSuspended(binding *appstudiov1alpha1.SnapshotEnvironmentBinding, componentName string) bool {
+	if binding.Spec.Suspend {
+		return true
+	}
+	for _, component := range binding.Spec.Components {
+		if component.Name == componentName {
+			return component.Suspend
+		}
+	}
+	return false
+}
+
+// SetSuspensionStatus updates the suspension status of the given binding from its spec:
+//   - status.suspendedSince is set to the current time when the binding is suspended, and cleared when it is resumed
+//   - status.suspendedComponents lists the individually suspended components, with the time each was suspended at
+//   - the Suspended condition of status.bindingConditions is True while the binding or any of its components is
+//     suspended, and False once they are all resumed
+//
+// The suspension times of components which remain suspended are preserved. Returns true if the status has changed.
+func SetSuspensionStatus(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) bool {
+	now := metav1.Now()
+	status := &binding.Status
+	changed := false
+
+	if binding.Spec.Suspend && status.SuspendedSince == nil {
+		status.SuspendedSince = &now
+		changed = true
+	} else if !binding.Spec.Suspend && status.SuspendedSince != nil {
+		status.SuspendedSince = nil
+		changed = true
+	}
+
+	since := map[string]metav1.Time{}
+	for _, component := range status.SuspendedComponents {
+		since[component.Name] = component.Since
+	}
+	var suspendedComponents []appstudiov1alpha1.SuspendedComponentStatus
+	var names []string
+	for _, component := range binding.Spec.Components {
+		if !component.Suspend {
+			continue
+		}
+		componentSince, exists := since[component.Name]
+		if !exists {
+			componentSince = now
+		}
+		suspendedComponents = append(suspendedComponents, appstudiov1alpha1.SuspendedComponentStatus{Name: component.Name, Since: componentSince})
+		names = append(names, component.Name)
+	}
+	if !equalSuspendedComponents(status.SuspendedComponents, suspendedComponents) {
+		status.SuspendedComponents = suspendedComponents
+		changed = true
+	}
+
+	condition := metav1.Condition{
+		Type:    appstudiov1alpha1.SnapshotEnvironmentBindingConditionSuspended,
+		Status:  metav1.ConditionFalse,
+		Reason:  appstudiov1alpha1.SnapshotEnvironmentBindingReasonResumed,
+		Message: "the binding is not suspended",
+	}
+	if binding.Spec.Suspend {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = appstudiov1alpha1.SnapshotEnvironmentBindingReasonSuspended
+		condition.Message = "the reconciliation of the binding and the sync of all of its components are suspended"
+	} else if len(names) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = appstudiov1alpha1.SnapshotEnvironmentBindingReasonComponentsSuspended
+		condition.Message = fmt.Sprintf("the sync of the following components is suspended: %s", strings.Join(names, ", "))
+	}
+	if conditions.Get(status.BindingConditions, condition.Type) != nil || condition.Status == metav1.ConditionTrue {
+		// A binding that was never suspended has no Suspended condition
+		changed = conditions.SetForObject(binding, &status.BindingConditions, condition) || changed
+	}
+
+	return changed
+}
+
+func equalSuspendedComponents(a, b []appstudiov1alpha1.SuspendedComponentStatus) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || !a[i].Since.Equal(&b[i].Since) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	scheme "github.com/redhat-appstudio/application-api/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ComponentDetectionQueriesGetter has a method to return a ComponentDetectionQueryInterface.
+// A group's client should implement this interface.
+type ComponentDetectionQueriesGetter interface {
+	ComponentDetectionQueries(namespace string) ComponentDetectionQueryInterface
+}
+
+// ComponentDetectionQueryInterface has methods to work with ComponentDetectionQuery resources.
+type ComponentDetectionQueryInterface interface {
+	Create(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.CreateOptions) (*v1alpha1.ComponentDetectionQuery, error)
+	Update(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (*v1alpha1.ComponentDetectionQuery, error)
+	UpdateStatus(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (*v1alpha1.ComponentDetectionQuery, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ComponentDetectionQuery, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ComponentDetectionQueryList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ComponentDetectionQuery, err error)
+	ComponentDetectionQueryExpansion
+}
+
+// componentDetectionQueries implements ComponentDetectionQueryInterface
+type componentDetectionQueries struct {
+	client rest.Interface
+	ns     string
+}
+
+// newComponentDetectionQueries returns a ComponentDetectionQueries
+func newComponentDetectionQueries(c *AppstudioV1alpha1Client, namespace string) *componentDetectionQueries {
+	return &componentDetectionQueries{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the componentDetectionQuery, and returns the corresponding componentDetectionQuery object, and an error if there is any.
+func (c *componentDetectionQueries) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	result = &v1alpha1.ComponentDetectionQuery{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ComponentDetectionQueries that match those selectors.
+func (c *componentDetectionQueries) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ComponentDetectionQueryList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ComponentDetectionQueryList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested componentDetectionQueries.
+func (c *componentDetectionQueries) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a componentDetectionQuery and creates it.  Returns the server's representation of the componentDetectionQuery, and an error, if there is any.
+func (c *componentDetectionQueries) Create(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.CreateOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	result = &v1alpha1.ComponentDetectionQuery{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(componentDetectionQuery).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a componentDetectionQuery and updates it. Returns the server's representation of the componentDetectionQuery, and an error, if there is any.
+func (c *componentDetectionQueries) Update(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	result = &v1alpha1.ComponentDetectionQuery{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		Name(componentDetectionQuery.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(componentDetectionQuery).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *componentDetectionQueries) UpdateStatus(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	result = &v1alpha1.ComponentDetectionQuery{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		Name(componentDetectionQuery.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(componentDetectionQuery).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the componentDetectionQuery and deletes it. Returns an error if one occurs.
+func (c *componentDetectionQueries) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *componentDetectionQueries) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched componentDetectionQuery.
+func (c *componentDetectionQueries) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	result = &v1alpha1.ComponentDetectionQuery{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("componentdetectionqueries").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	scheme "github.com/redhat-appstudio/application-api/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DeploymentTargetsGetter has a method to return a DeploymentTargetInterface.
+// A group's client should implement this interface.
+type DeploymentTargetsGetter interface {
+	DeploymentTargets(namespace string) DeploymentTargetInterface
+}
+
+// DeploymentTargetInterface has methods to work with DeploymentTarget resources.
+type DeploymentTargetInterface interface {
+	Create(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.CreateOptions) (*v1alpha1.DeploymentTarget, error)
+	Update(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (*v1alpha1.DeploymentTarget, error)
+	UpdateStatus(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (*v1alpha1.DeploymentTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.DeploymentTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DeploymentTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTarget, err error)
+	DeploymentTargetExpansion
+}
+
+// deploymentTargets implements DeploymentTargetInterface
+type deploymentTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDeploymentTargets returns a DeploymentTargets
+func newDeploymentTargets(c *AppstudioV1alpha1Client, namespace string) *deploymentTargets {
+	return &deploymentTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the deploymentTarget, and returns the corresponding deploymentTarget object, and an error if there is any.
+func (c *deploymentTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	result = &v1alpha1.DeploymentTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DeploymentTargets that match those selectors.
+func (c *deploymentTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeploymentTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DeploymentTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested deploymentTargets.
+func (c *deploymentTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a deploymentTarget and creates it.  Returns the server's representation of the deploymentTarget, and an error, if there is any.
+func (c *deploymentTargets) Create(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.CreateOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	result = &v1alpha1.DeploymentTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a deploymentTarget and updates it. Returns the server's representation of the deploymentTarget, and an error, if there is any.
+func (c *deploymentTargets) Update(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	result = &v1alpha1.DeploymentTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		Name(deploymentTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *deploymentTargets) UpdateStatus(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	result = &v1alpha1.DeploymentTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		Name(deploymentTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the deploymentTarget and deletes it. Returns an error if one occurs.
+func (c *deploymentTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *deploymentTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched deploymentTarget.
+func (c *deploymentTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTarget, err error) {
+	result = &v1alpha1.DeploymentTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("deploymenttargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
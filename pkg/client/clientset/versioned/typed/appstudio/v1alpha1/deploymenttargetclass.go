@@ -0,0 +1,194 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	scheme "github.com/redhat-appstudio/application-api/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DeploymentTargetClassesGetter has a method to return a DeploymentTargetClassInterface.
+// A group's client should implement this interface.
+type DeploymentTargetClassesGetter interface {
+	DeploymentTargetClasses(namespace string) DeploymentTargetClassInterface
+}
+
+// DeploymentTargetClassInterface has methods to work with DeploymentTargetClass resources.
+type DeploymentTargetClassInterface interface {
+	Create(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.CreateOptions) (*v1alpha1.DeploymentTargetClass, error)
+	Update(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (*v1alpha1.DeploymentTargetClass, error)
+	UpdateStatus(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (*v1alpha1.DeploymentTargetClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.DeploymentTargetClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DeploymentTargetClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTargetClass, err error)
+	DeploymentTargetClassExpansion
+}
+
+// deploymentTargetClasses implements DeploymentTargetClassInterface
+type deploymentTargetClasses struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDeploymentTargetClasses returns a DeploymentTargetClasses
+func newDeploymentTargetClasses(c *AppstudioV1alpha1Client, namespace string) *deploymentTargetClasses {
+	return &deploymentTargetClasses{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the deploymentTargetClass, and returns the corresponding deploymentTargetClass object, and an error if there is any.
+func (c *deploymentTargetClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	result = &v1alpha1.DeploymentTargetClass{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DeploymentTargetClasses that match those selectors.
+func (c *deploymentTargetClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeploymentTargetClassList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DeploymentTargetClassList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested deploymentTargetClasses.
+func (c *deploymentTargetClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a deploymentTargetClass and creates it.  Returns the server's representation of the deploymentTargetClass, and an error, if there is any.
+func (c *deploymentTargetClasses) Create(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.CreateOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	result = &v1alpha1.DeploymentTargetClass{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTargetClass).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a deploymentTargetClass and updates it. Returns the server's representation of the deploymentTargetClass, and an error, if there is any.
+func (c *deploymentTargetClasses) Update(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	result = &v1alpha1.DeploymentTargetClass{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		Name(deploymentTargetClass.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTargetClass).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *deploymentTargetClasses) UpdateStatus(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	result = &v1alpha1.DeploymentTargetClass{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		Name(deploymentTargetClass.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deploymentTargetClass).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the deploymentTargetClass and deletes it. Returns an error if one occurs.
+func (c *deploymentTargetClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *deploymentTargetClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched deploymentTargetClass.
+func (c *deploymentTargetClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTargetClass, err error) {
+	result = &v1alpha1.DeploymentTargetClass{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("deploymenttargetclasses").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
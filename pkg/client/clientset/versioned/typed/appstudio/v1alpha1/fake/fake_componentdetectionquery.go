@@ -0,0 +1,141 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeComponentDetectionQueries implements ComponentDetectionQueryInterface
+type FakeComponentDetectionQueries struct {
+	Fake *FakeAppstudioV1alpha1
+	ns   string
+}
+
+var componentdetectionqueriesResource = schema.GroupVersionResource{Group: "appstudio.redhat.com", Version: "v1alpha1", Resource: "componentdetectionqueries"}
+
+var componentdetectionqueriesKind = schema.GroupVersionKind{Group: "appstudio.redhat.com", Version: "v1alpha1", Kind: "ComponentDetectionQuery"}
+
+// Get takes name of the componentDetectionQuery, and returns the corresponding componentDetectionQuery object, and an error if there is any.
+func (c *FakeComponentDetectionQueries) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(componentdetectionqueriesResource, c.ns, name), &v1alpha1.ComponentDetectionQuery{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), err
+}
+
+// List takes label and field selectors, and returns the list of ComponentDetectionQueries that match those selectors.
+func (c *FakeComponentDetectionQueries) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ComponentDetectionQueryList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(componentdetectionqueriesResource, componentdetectionqueriesKind, c.ns, opts), &v1alpha1.ComponentDetectionQueryList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ComponentDetectionQueryList{ListMeta: obj.(*v1alpha1.ComponentDetectionQueryList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ComponentDetectionQueryList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested componentDetectionQueries.
+func (c *FakeComponentDetectionQueries) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(componentdetectionqueriesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a componentDetectionQuery and creates it.  Returns the server's representation of the componentDetectionQuery, and an error, if there is any.
+func (c *FakeComponentDetectionQueries) Create(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.CreateOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(componentdetectionqueriesResource, c.ns, componentDetectionQuery), &v1alpha1.ComponentDetectionQuery{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), err
+}
+
+// Update takes the representation of a componentDetectionQuery and updates it. Returns the server's representation of the componentDetectionQuery, and an error, if there is any.
+func (c *FakeComponentDetectionQueries) Update(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(componentdetectionqueriesResource, c.ns, componentDetectionQuery), &v1alpha1.ComponentDetectionQuery{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeComponentDetectionQueries) UpdateStatus(ctx context.Context, componentDetectionQuery *v1alpha1.ComponentDetectionQuery, opts v1.UpdateOptions) (*v1alpha1.ComponentDetectionQuery, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(componentdetectionqueriesResource, "status", c.ns, componentDetectionQuery), &v1alpha1.ComponentDetectionQuery{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), err
+}
+
+// Delete takes name of the componentDetectionQuery and deletes it. Returns an error if one occurs.
+func (c *FakeComponentDetectionQueries) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(componentdetectionqueriesResource, c.ns, name, opts), &v1alpha1.ComponentDetectionQuery{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeComponentDetectionQueries) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(componentdetectionqueriesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ComponentDetectionQueryList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched componentDetectionQuery.
+func (c *FakeComponentDetectionQueries) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ComponentDetectionQuery, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(componentdetectionqueriesResource, c.ns, name, pt, data, subresources...), &v1alpha1.ComponentDetectionQuery{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), err
+}
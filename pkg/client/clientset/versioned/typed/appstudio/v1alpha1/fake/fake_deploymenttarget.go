@@ -0,0 +1,141 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDeploymentTargets implements DeploymentTargetInterface
+type FakeDeploymentTargets struct {
+	Fake *FakeAppstudioV1alpha1
+	ns   string
+}
+
+var deploymenttargetsResource = schema.GroupVersionResource{Group: "appstudio.redhat.com", Version: "v1alpha1", Resource: "deploymenttargets"}
+
+var deploymenttargetsKind = schema.GroupVersionKind{Group: "appstudio.redhat.com", Version: "v1alpha1", Kind: "DeploymentTarget"}
+
+// Get takes name of the deploymentTarget, and returns the corresponding deploymentTarget object, and an error if there is any.
+func (c *FakeDeploymentTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(deploymenttargetsResource, c.ns, name), &v1alpha1.DeploymentTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTarget), err
+}
+
+// List takes label and field selectors, and returns the list of DeploymentTargets that match those selectors.
+func (c *FakeDeploymentTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeploymentTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(deploymenttargetsResource, deploymenttargetsKind, c.ns, opts), &v1alpha1.DeploymentTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DeploymentTargetList{ListMeta: obj.(*v1alpha1.DeploymentTargetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DeploymentTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested deploymentTargets.
+func (c *FakeDeploymentTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(deploymenttargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a deploymentTarget and creates it.  Returns the server's representation of the deploymentTarget, and an error, if there is any.
+func (c *FakeDeploymentTargets) Create(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.CreateOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(deploymenttargetsResource, c.ns, deploymentTarget), &v1alpha1.DeploymentTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTarget), err
+}
+
+// Update takes the representation of a deploymentTarget and updates it. Returns the server's representation of the deploymentTarget, and an error, if there is any.
+func (c *FakeDeploymentTargets) Update(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(deploymenttargetsResource, c.ns, deploymentTarget), &v1alpha1.DeploymentTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDeploymentTargets) UpdateStatus(ctx context.Context, deploymentTarget *v1alpha1.DeploymentTarget, opts v1.UpdateOptions) (*v1alpha1.DeploymentTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(deploymenttargetsResource, "status", c.ns, deploymentTarget), &v1alpha1.DeploymentTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTarget), err
+}
+
+// Delete takes name of the deploymentTarget and deletes it. Returns an error if one occurs.
+func (c *FakeDeploymentTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(deploymenttargetsResource, c.ns, name, opts), &v1alpha1.DeploymentTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDeploymentTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(deploymenttargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DeploymentTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched deploymentTarget.
+func (c *FakeDeploymentTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(deploymenttargetsResource, c.ns, name, pt, data, subresources...), &v1alpha1.DeploymentTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTarget), err
+}
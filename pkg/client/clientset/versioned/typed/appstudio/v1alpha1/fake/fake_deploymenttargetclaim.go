@@ -0,0 +1,141 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDeploymentTargetClaims implements DeploymentTargetClaimInterface
+type FakeDeploymentTargetClaims struct {
+	Fake *FakeAppstudioV1alpha1
+	ns   string
+}
+
+var deploymenttargetclaimsResource = schema.GroupVersionResource{Group: "appstudio.redhat.com", Version: "v1alpha1", Resource: "deploymenttargetclaims"}
+
+var deploymenttargetclaimsKind = schema.GroupVersionKind{Group: "appstudio.redhat.com", Version: "v1alpha1", Kind: "DeploymentTargetClaim"}
+
+// Get takes name of the deploymentTargetClaim, and returns the corresponding deploymentTargetClaim object, and an error if there is any.
+func (c *FakeDeploymentTargetClaims) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeploymentTargetClaim, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(deploymenttargetclaimsResource, c.ns, name), &v1alpha1.DeploymentTargetClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), err
+}
+
+// List takes label and field selectors, and returns the list of DeploymentTargetClaims that match those selectors.
+func (c *FakeDeploymentTargetClaims) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeploymentTargetClaimList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(deploymenttargetclaimsResource, deploymenttargetclaimsKind, c.ns, opts), &v1alpha1.DeploymentTargetClaimList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DeploymentTargetClaimList{ListMeta: obj.(*v1alpha1.DeploymentTargetClaimList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DeploymentTargetClaimList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested deploymentTargetClaims.
+func (c *FakeDeploymentTargetClaims) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(deploymenttargetclaimsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a deploymentTargetClaim and creates it.  Returns the server's representation of the deploymentTargetClaim, and an error, if there is any.
+func (c *FakeDeploymentTargetClaims) Create(ctx context.Context, deploymentTargetClaim *v1alpha1.DeploymentTargetClaim, opts v1.CreateOptions) (result *v1alpha1.DeploymentTargetClaim, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(deploymenttargetclaimsResource, c.ns, deploymentTargetClaim), &v1alpha1.DeploymentTargetClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), err
+}
+
+// Update takes the representation of a deploymentTargetClaim and updates it. Returns the server's representation of the deploymentTargetClaim, and an error, if there is any.
+func (c *FakeDeploymentTargetClaims) Update(ctx context.Context, deploymentTargetClaim *v1alpha1.DeploymentTargetClaim, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTargetClaim, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(deploymenttargetclaimsResource, c.ns, deploymentTargetClaim), &v1alpha1.DeploymentTargetClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDeploymentTargetClaims) UpdateStatus(ctx context.Context, deploymentTargetClaim *v1alpha1.DeploymentTargetClaim, opts v1.UpdateOptions) (*v1alpha1.DeploymentTargetClaim, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(deploymenttargetclaimsResource, "status", c.ns, deploymentTargetClaim), &v1alpha1.DeploymentTargetClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), err
+}
+
+// Delete takes name of the deploymentTargetClaim and deletes it. Returns an error if one occurs.
+func (c *FakeDeploymentTargetClaims) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(deploymenttargetclaimsResource, c.ns, name, opts), &v1alpha1.DeploymentTargetClaim{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDeploymentTargetClaims) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(deploymenttargetclaimsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DeploymentTargetClaimList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched deploymentTargetClaim.
+func (c *FakeDeploymentTargetClaims) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTargetClaim, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(deploymenttargetclaimsResource, c.ns, name, pt, data, subresources...), &v1alpha1.DeploymentTargetClaim{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), err
+}
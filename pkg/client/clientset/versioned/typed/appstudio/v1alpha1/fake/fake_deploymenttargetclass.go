@@ -0,0 +1,141 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDeploymentTargetClasses implements DeploymentTargetClassInterface
+type FakeDeploymentTargetClasses struct {
+	Fake *FakeAppstudioV1alpha1
+	ns   string
+}
+
+var deploymenttargetclassesResource = schema.GroupVersionResource{Group: "appstudio.redhat.com", Version: "v1alpha1", Resource: "deploymenttargetclasses"}
+
+var deploymenttargetclassesKind = schema.GroupVersionKind{Group: "appstudio.redhat.com", Version: "v1alpha1", Kind: "DeploymentTargetClass"}
+
+// Get takes name of the deploymentTargetClass, and returns the corresponding deploymentTargetClass object, and an error if there is any.
+func (c *FakeDeploymentTargetClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(deploymenttargetclassesResource, c.ns, name), &v1alpha1.DeploymentTargetClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), err
+}
+
+// List takes label and field selectors, and returns the list of DeploymentTargetClasses that match those selectors.
+func (c *FakeDeploymentTargetClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeploymentTargetClassList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(deploymenttargetclassesResource, deploymenttargetclassesKind, c.ns, opts), &v1alpha1.DeploymentTargetClassList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DeploymentTargetClassList{ListMeta: obj.(*v1alpha1.DeploymentTargetClassList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DeploymentTargetClassList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested deploymentTargetClasses.
+func (c *FakeDeploymentTargetClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(deploymenttargetclassesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a deploymentTargetClass and creates it.  Returns the server's representation of the deploymentTargetClass, and an error, if there is any.
+func (c *FakeDeploymentTargetClasses) Create(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.CreateOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(deploymenttargetclassesResource, c.ns, deploymentTargetClass), &v1alpha1.DeploymentTargetClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), err
+}
+
+// Update takes the representation of a deploymentTargetClass and updates it. Returns the server's representation of the deploymentTargetClass, and an error, if there is any.
+func (c *FakeDeploymentTargetClasses) Update(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (result *v1alpha1.DeploymentTargetClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(deploymenttargetclassesResource, c.ns, deploymentTargetClass), &v1alpha1.DeploymentTargetClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDeploymentTargetClasses) UpdateStatus(ctx context.Context, deploymentTargetClass *v1alpha1.DeploymentTargetClass, opts v1.UpdateOptions) (*v1alpha1.DeploymentTargetClass, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(deploymenttargetclassesResource, "status", c.ns, deploymentTargetClass), &v1alpha1.DeploymentTargetClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), err
+}
+
+// Delete takes name of the deploymentTargetClass and deletes it. Returns an error if one occurs.
+func (c *FakeDeploymentTargetClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(deploymenttargetclassesResource, c.ns, name, opts), &v1alpha1.DeploymentTargetClass{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDeploymentTargetClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(deploymenttargetclassesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DeploymentTargetClassList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched deploymentTargetClass.
+func (c *FakeDeploymentTargetClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeploymentTargetClass, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(deploymenttargetclassesResource, c.ns, name, pt, data, subresources...), &v1alpha1.DeploymentTargetClass{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), err
+}
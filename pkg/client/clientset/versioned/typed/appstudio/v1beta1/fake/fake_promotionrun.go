@@ -0,0 +1,141 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/redhat-appstudio/application-api/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePromotionRuns implements PromotionRunInterface
+type FakePromotionRuns struct {
+	Fake *FakeAppstudioV1beta1
+	ns   string
+}
+
+var promotionrunsResource = schema.GroupVersionResource{Group: "appstudio.redhat.com", Version: "v1beta1", Resource: "promotionruns"}
+
+var promotionrunsKind = schema.GroupVersionKind{Group: "appstudio.redhat.com", Version: "v1beta1", Kind: "PromotionRun"}
+
+// Get takes name of the promotionRun, and returns the corresponding promotionRun object, and an error if there is any.
+func (c *FakePromotionRuns) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.PromotionRun, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(promotionrunsResource, c.ns, name), &v1beta1.PromotionRun{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PromotionRun), err
+}
+
+// List takes label and field selectors, and returns the list of PromotionRuns that match those selectors.
+func (c *FakePromotionRuns) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.PromotionRunList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(promotionrunsResource, promotionrunsKind, c.ns, opts), &v1beta1.PromotionRunList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.PromotionRunList{ListMeta: obj.(*v1beta1.PromotionRunList).ListMeta}
+	for _, item := range obj.(*v1beta1.PromotionRunList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested promotionRuns.
+func (c *FakePromotionRuns) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(promotionrunsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a promotionRun and creates it.  Returns the server's representation of the promotionRun, and an error, if there is any.
+func (c *FakePromotionRuns) Create(ctx context.Context, promotionRun *v1beta1.PromotionRun, opts v1.CreateOptions) (result *v1beta1.PromotionRun, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(promotionrunsResource, c.ns, promotionRun), &v1beta1.PromotionRun{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PromotionRun), err
+}
+
+// Update takes the representation of a promotionRun and updates it. Returns the server's representation of the promotionRun, and an error, if there is any.
+func (c *FakePromotionRuns) Update(ctx context.Context, promotionRun *v1beta1.PromotionRun, opts v1.UpdateOptions) (result *v1beta1.PromotionRun, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(promotionrunsResource, c.ns, promotionRun), &v1beta1.PromotionRun{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PromotionRun), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePromotionRuns) UpdateStatus(ctx context.Context, promotionRun *v1beta1.PromotionRun, opts v1.UpdateOptions) (*v1beta1.PromotionRun, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(promotionrunsResource, "status", c.ns, promotionRun), &v1beta1.PromotionRun{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PromotionRun), err
+}
+
+// Delete takes name of the promotionRun and deletes it. Returns an error if one occurs.
+func (c *FakePromotionRuns) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(promotionrunsResource, c.ns, name, opts), &v1beta1.PromotionRun{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePromotionRuns) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(promotionrunsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.PromotionRunList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched promotionRun.
+func (c *FakePromotionRuns) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.PromotionRun, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(promotionrunsResource, c.ns, name, pt, data, subresources...), &v1beta1.PromotionRun{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PromotionRun), err
+}
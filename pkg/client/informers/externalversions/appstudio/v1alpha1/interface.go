@@ -0,0 +1,107 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/redhat-appstudio/application-api/pkg/client/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// Applications returns a ApplicationInformer.
+	Applications() ApplicationInformer
+	// Components returns a ComponentInformer.
+	Components() ComponentInformer
+	// ComponentDetectionQueries returns a ComponentDetectionQueryInformer.
+	ComponentDetectionQueries() ComponentDetectionQueryInformer
+	// DeploymentTargets returns a DeploymentTargetInformer.
+	DeploymentTargets() DeploymentTargetInformer
+	// DeploymentTargetClaims returns a DeploymentTargetClaimInformer.
+	DeploymentTargetClaims() DeploymentTargetClaimInformer
+	// DeploymentTargetClasses returns a DeploymentTargetClassInformer.
+	DeploymentTargetClasses() DeploymentTargetClassInformer
+	// Environments returns a EnvironmentInformer.
+	Environments() EnvironmentInformer
+	// PromotionRuns returns a PromotionRunInformer.
+	PromotionRuns() PromotionRunInformer
+	// Snapshots returns a SnapshotInformer.
+	Snapshots() SnapshotInformer
+	// SnapshotEnvironmentBindings returns a SnapshotEnvironmentBindingInformer.
+	SnapshotEnvironmentBindings() SnapshotEnvironmentBindingInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Applications returns a ApplicationInformer.
+func (v *version) Applications() ApplicationInformer {
+	return &applicationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Components returns a ComponentInformer.
+func (v *version) Components() ComponentInformer {
+	return &componentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ComponentDetectionQueries returns a ComponentDetectionQueryInformer.
+func (v *version) ComponentDetectionQueries() ComponentDetectionQueryInformer {
+	return &componentDetectionQueryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DeploymentTargets returns a DeploymentTargetInformer.
+func (v *version) DeploymentTargets() DeploymentTargetInformer {
+	return &deploymentTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DeploymentTargetClaims returns a DeploymentTargetClaimInformer.
+func (v *version) DeploymentTargetClaims() DeploymentTargetClaimInformer {
+	return &deploymentTargetClaimInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DeploymentTargetClasses returns a DeploymentTargetClassInformer.
+func (v *version) DeploymentTargetClasses() DeploymentTargetClassInformer {
+	return &deploymentTargetClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Environments returns a EnvironmentInformer.
+func (v *version) Environments() EnvironmentInformer {
+	return &environmentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// PromotionRuns returns a PromotionRunInformer.
+func (v *version) PromotionRuns() PromotionRunInformer {
+	return &promotionRunInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Snapshots returns a SnapshotInformer.
+func (v *version) Snapshots() SnapshotInformer {
+	return &snapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SnapshotEnvironmentBindings returns a SnapshotEnvironmentBindingInformer.
+func (v *version) SnapshotEnvironmentBindings() SnapshotEnvironmentBindingInformer {
+	return &snapshotEnvironmentBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package externalversions
+
+import (
+	"fmt"
+
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	v1beta1 "github.com/redhat-appstudio/application-api/api/v1beta1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// GenericInformer is type of SharedIndexInformer which will locate and delegate to other
+// sharedInformers based on type
+type GenericInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() cache.GenericLister
+}
+
+type genericInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupResource
+}
+
+// Informer returns the SharedIndexInformer.
+func (f *genericInformer) Informer() cache.SharedIndexInformer {
+	return f.informer
+}
+
+// Lister returns the GenericLister.
+func (f *genericInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(f.Informer().GetIndexer(), f.resource)
+}
+
+// ForResource gives generic access to a shared informer of the matching type
+// TODO extend this to unknown resources with a client pool
+func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
+	switch resource {
+	// Group=appstudio.redhat.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("applications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().Applications().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("components"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().Components().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("componentdetectionqueries"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().ComponentDetectionQueries().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("deploymenttargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().DeploymentTargets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("deploymenttargetclaims"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().DeploymentTargetClaims().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("deploymenttargetclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().DeploymentTargetClasses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("environments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().Environments().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("promotionruns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().PromotionRuns().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("snapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().Snapshots().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("snapshotenvironmentbindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1alpha1().SnapshotEnvironmentBindings().Informer()}, nil
+
+		// Group=appstudio.redhat.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("environments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1beta1().Environments().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("promotionruns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1beta1().PromotionRuns().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("snapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Appstudio().V1beta1().Snapshots().Informer()}, nil
+
+	}
+
+	return nil, fmt.Errorf("no informer found for %v", resource)
+}
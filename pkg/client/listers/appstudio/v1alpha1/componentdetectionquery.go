@@ -0,0 +1,98 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ComponentDetectionQueryLister helps list ComponentDetectionQueries.
+// All objects returned here must be treated as read-only.
+type ComponentDetectionQueryLister interface {
+	// List lists all ComponentDetectionQueries in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ComponentDetectionQuery, err error)
+	// ComponentDetectionQueries returns an object that can list and get ComponentDetectionQueries.
+	ComponentDetectionQueries(namespace string) ComponentDetectionQueryNamespaceLister
+	ComponentDetectionQueryListerExpansion
+}
+
+// componentDetectionQueryLister implements the ComponentDetectionQueryLister interface.
+type componentDetectionQueryLister struct {
+	indexer cache.Indexer
+}
+
+// NewComponentDetectionQueryLister returns a new ComponentDetectionQueryLister.
+func NewComponentDetectionQueryLister(indexer cache.Indexer) ComponentDetectionQueryLister {
+	return &componentDetectionQueryLister{indexer: indexer}
+}
+
+// List lists all ComponentDetectionQueries in the indexer.
+func (s *componentDetectionQueryLister) List(selector labels.Selector) (ret []*v1alpha1.ComponentDetectionQuery, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ComponentDetectionQuery))
+	})
+	return ret, err
+}
+
+// ComponentDetectionQueries returns an object that can list and get ComponentDetectionQueries.
+func (s *componentDetectionQueryLister) ComponentDetectionQueries(namespace string) ComponentDetectionQueryNamespaceLister {
+	return componentDetectionQueryNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ComponentDetectionQueryNamespaceLister helps list and get ComponentDetectionQueries.
+// All objects returned here must be treated as read-only.
+type ComponentDetectionQueryNamespaceLister interface {
+	// List lists all ComponentDetectionQueries in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ComponentDetectionQuery, err error)
+	// Get retrieves the ComponentDetectionQuery from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ComponentDetectionQuery, error)
+	ComponentDetectionQueryNamespaceListerExpansion
+}
+
+// componentDetectionQueryNamespaceLister implements the ComponentDetectionQueryNamespaceLister
+// interface.
+type componentDetectionQueryNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ComponentDetectionQueries in the indexer for a given namespace.
+func (s componentDetectionQueryNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ComponentDetectionQuery, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ComponentDetectionQuery))
+	})
+	return ret, err
+}
+
+// Get retrieves the ComponentDetectionQuery from the indexer for a given namespace and name.
+func (s componentDetectionQueryNamespaceLister) Get(name string) (*v1alpha1.ComponentDetectionQuery, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("componentdetectionquery"), name)
+	}
+	return obj.(*v1alpha1.ComponentDetectionQuery), nil
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeploymentTargetLister helps list DeploymentTargets.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetLister interface {
+	// List lists all DeploymentTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTarget, err error)
+	// DeploymentTargets returns an object that can list and get DeploymentTargets.
+	DeploymentTargets(namespace string) DeploymentTargetNamespaceLister
+	DeploymentTargetListerExpansion
+}
+
+// deploymentTargetLister implements the DeploymentTargetLister interface.
+type deploymentTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewDeploymentTargetLister returns a new DeploymentTargetLister.
+func NewDeploymentTargetLister(indexer cache.Indexer) DeploymentTargetLister {
+	return &deploymentTargetLister{indexer: indexer}
+}
+
+// List lists all DeploymentTargets in the indexer.
+func (s *deploymentTargetLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTarget))
+	})
+	return ret, err
+}
+
+// DeploymentTargets returns an object that can list and get DeploymentTargets.
+func (s *deploymentTargetLister) DeploymentTargets(namespace string) DeploymentTargetNamespaceLister {
+	return deploymentTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DeploymentTargetNamespaceLister helps list and get DeploymentTargets.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetNamespaceLister interface {
+	// List lists all DeploymentTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTarget, err error)
+	// Get retrieves the DeploymentTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DeploymentTarget, error)
+	DeploymentTargetNamespaceListerExpansion
+}
+
+// deploymentTargetNamespaceLister implements the DeploymentTargetNamespaceLister
+// interface.
+type deploymentTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DeploymentTargets in the indexer for a given namespace.
+func (s deploymentTargetNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the DeploymentTarget from the indexer for a given namespace and name.
+func (s deploymentTargetNamespaceLister) Get(name string) (*v1alpha1.DeploymentTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("deploymenttarget"), name)
+	}
+	return obj.(*v1alpha1.DeploymentTarget), nil
+}
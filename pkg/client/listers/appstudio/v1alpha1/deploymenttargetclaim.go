@@ -0,0 +1,98 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeploymentTargetClaimLister helps list DeploymentTargetClaims.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetClaimLister interface {
+	// List lists all DeploymentTargetClaims in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClaim, err error)
+	// DeploymentTargetClaims returns an object that can list and get DeploymentTargetClaims.
+	DeploymentTargetClaims(namespace string) DeploymentTargetClaimNamespaceLister
+	DeploymentTargetClaimListerExpansion
+}
+
+// deploymentTargetClaimLister implements the DeploymentTargetClaimLister interface.
+type deploymentTargetClaimLister struct {
+	indexer cache.Indexer
+}
+
+// NewDeploymentTargetClaimLister returns a new DeploymentTargetClaimLister.
+func NewDeploymentTargetClaimLister(indexer cache.Indexer) DeploymentTargetClaimLister {
+	return &deploymentTargetClaimLister{indexer: indexer}
+}
+
+// List lists all DeploymentTargetClaims in the indexer.
+func (s *deploymentTargetClaimLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClaim, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTargetClaim))
+	})
+	return ret, err
+}
+
+// DeploymentTargetClaims returns an object that can list and get DeploymentTargetClaims.
+func (s *deploymentTargetClaimLister) DeploymentTargetClaims(namespace string) DeploymentTargetClaimNamespaceLister {
+	return deploymentTargetClaimNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DeploymentTargetClaimNamespaceLister helps list and get DeploymentTargetClaims.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetClaimNamespaceLister interface {
+	// List lists all DeploymentTargetClaims in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClaim, err error)
+	// Get retrieves the DeploymentTargetClaim from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DeploymentTargetClaim, error)
+	DeploymentTargetClaimNamespaceListerExpansion
+}
+
+// deploymentTargetClaimNamespaceLister implements the DeploymentTargetClaimNamespaceLister
+// interface.
+type deploymentTargetClaimNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DeploymentTargetClaims in the indexer for a given namespace.
+func (s deploymentTargetClaimNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClaim, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTargetClaim))
+	})
+	return ret, err
+}
+
+// Get retrieves the DeploymentTargetClaim from the indexer for a given namespace and name.
+func (s deploymentTargetClaimNamespaceLister) Get(name string) (*v1alpha1.DeploymentTargetClaim, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("deploymenttargetclaim"), name)
+	}
+	return obj.(*v1alpha1.DeploymentTargetClaim), nil
+}
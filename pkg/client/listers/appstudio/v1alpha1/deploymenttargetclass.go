@@ -0,0 +1,98 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeploymentTargetClassLister helps list DeploymentTargetClasses.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetClassLister interface {
+	// List lists all DeploymentTargetClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClass, err error)
+	// DeploymentTargetClasses returns an object that can list and get DeploymentTargetClasses.
+	DeploymentTargetClasses(namespace string) DeploymentTargetClassNamespaceLister
+	DeploymentTargetClassListerExpansion
+}
+
+// deploymentTargetClassLister implements the DeploymentTargetClassLister interface.
+type deploymentTargetClassLister struct {
+	indexer cache.Indexer
+}
+
+// NewDeploymentTargetClassLister returns a new DeploymentTargetClassLister.
+func NewDeploymentTargetClassLister(indexer cache.Indexer) DeploymentTargetClassLister {
+	return &deploymentTargetClassLister{indexer: indexer}
+}
+
+// List lists all DeploymentTargetClasses in the indexer.
+func (s *deploymentTargetClassLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClass, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTargetClass))
+	})
+	return ret, err
+}
+
+// DeploymentTargetClasses returns an object that can list and get DeploymentTargetClasses.
+func (s *deploymentTargetClassLister) DeploymentTargetClasses(namespace string) DeploymentTargetClassNamespaceLister {
+	return deploymentTargetClassNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DeploymentTargetClassNamespaceLister helps list and get DeploymentTargetClasses.
+// All objects returned here must be treated as read-only.
+type DeploymentTargetClassNamespaceLister interface {
+	// List lists all DeploymentTargetClasses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClass, err error)
+	// Get retrieves the DeploymentTargetClass from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DeploymentTargetClass, error)
+	DeploymentTargetClassNamespaceListerExpansion
+}
+
+// deploymentTargetClassNamespaceLister implements the DeploymentTargetClassNamespaceLister
+// interface.
+type deploymentTargetClassNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DeploymentTargetClasses in the indexer for a given namespace.
+func (s deploymentTargetClassNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DeploymentTargetClass, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeploymentTargetClass))
+	})
+	return ret, err
+}
+
+// Get retrieves the DeploymentTargetClass from the indexer for a given namespace and name.
+func (s deploymentTargetClassNamespaceLister) Get(name string) (*v1alpha1.DeploymentTargetClass, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("deploymenttargetclass"), name)
+	}
+	return obj.(*v1alpha1.DeploymentTargetClass), nil
+}
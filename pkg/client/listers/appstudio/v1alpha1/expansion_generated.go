@@ -0,0 +1,98 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ApplicationListerExpansion allows custom methods to be added to
+// ApplicationLister.
+type ApplicationListerExpansion interface{}
+
+// ApplicationNamespaceListerExpansion allows custom methods to be added to
+// ApplicationNamespaceLister.
+type ApplicationNamespaceListerExpansion interface{}
+
+// ComponentListerExpansion allows custom methods to be added to
+// ComponentLister.
+type ComponentListerExpansion interface{}
+
+// ComponentNamespaceListerExpansion allows custom methods to be added to
+// ComponentNamespaceLister.
+type ComponentNamespaceListerExpansion interface{}
+
+// ComponentDetectionQueryListerExpansion allows custom methods to be added to
+// ComponentDetectionQueryLister.
+type ComponentDetectionQueryListerExpansion interface{}
+
+// ComponentDetectionQueryNamespaceListerExpansion allows custom methods to be added to
+// ComponentDetectionQueryNamespaceLister.
+type ComponentDetectionQueryNamespaceListerExpansion interface{}
+
+// DeploymentTargetListerExpansion allows custom methods to be added to
+// DeploymentTargetLister.
+type DeploymentTargetListerExpansion interface{}
+
+// DeploymentTargetNamespaceListerExpansion allows custom methods to be added to
+// DeploymentTargetNamespaceLister.
+type DeploymentTargetNamespaceListerExpansion interface{}
+
+// DeploymentTargetClaimListerExpansion allows custom methods to be added to
+// DeploymentTargetClaimLister.
+type DeploymentTargetClaimListerExpansion interface{}
+
+// DeploymentTargetClaimNamespaceListerExpansion allows custom methods to be added to
+// DeploymentTargetClaimNamespaceLister.
+type DeploymentTargetClaimNamespaceListerExpansion interface{}
+
+// DeploymentTargetClassListerExpansion allows custom methods to be added to
+// DeploymentTargetClassLister.
+type DeploymentTargetClassListerExpansion interface{}
+
+// DeploymentTargetClassNamespaceListerExpansion allows custom methods to be added to
+// DeploymentTargetClassNamespaceLister.
+type DeploymentTargetClassNamespaceListerExpansion interface{}
+
+// EnvironmentListerExpansion allows custom methods to be added to
+// EnvironmentLister.
+type EnvironmentListerExpansion interface{}
+
+// EnvironmentNamespaceListerExpansion allows custom methods to be added to
+// EnvironmentNamespaceLister.
+type EnvironmentNamespaceListerExpansion interface{}
+
+// PromotionRunListerExpansion allows custom methods to be added to
+// PromotionRunLister.
+type PromotionRunListerExpansion interface{}
+
+// PromotionRunNamespaceListerExpansion allows custom methods to be added to
+// PromotionRunNamespaceLister.
+type PromotionRunNamespaceListerExpansion interface{}
+
+// SnapshotListerExpansion allows custom methods to be added to
+// SnapshotLister.
+type SnapshotListerExpansion interface{}
+
+// SnapshotNamespaceListerExpansion allows custom methods to be added to
+// SnapshotNamespaceLister.
+type SnapshotNamespaceListerExpansion interface{}
+
+// SnapshotEnvironmentBindingListerExpansion allows custom methods to be added to
+// SnapshotEnvironmentBindingLister.
+type SnapshotEnvironmentBindingListerExpansion interface{}
+
+// SnapshotEnvironmentBindingNamespaceListerExpansion allows custom methods to be added to
+// SnapshotEnvironmentBindingNamespaceLister.
+type SnapshotEnvironmentBindingNamespaceListerExpansion interface{}
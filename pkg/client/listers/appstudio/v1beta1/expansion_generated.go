@@ -0,0 +1,42 @@
+/*
+Copyright 2021-2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// EnvironmentListerExpansion allows custom methods to be added to
+// EnvironmentLister.
+type EnvironmentListerExpansion interface{}
+
+// EnvironmentNamespaceListerExpansion allows custom methods to be added to
+// EnvironmentNamespaceLister.
+type EnvironmentNamespaceListerExpansion interface{}
+
+// PromotionRunListerExpansion allows custom methods to be added to
+// PromotionRunLister.
+type PromotionRunListerExpansion interface{}
+
+// PromotionRunNamespaceListerExpansion allows custom methods to be added to
+// PromotionRunNamespaceLister.
+type PromotionRunNamespaceListerExpansion interface{}
+
+// SnapshotListerExpansion allows custom methods to be added to
+// SnapshotLister.
+type SnapshotListerExpansion interface{}
+
+// SnapshotNamespaceListerExpansion allows custom methods to be added to
+// SnapshotNamespaceLister.
+type SnapshotNamespaceListerExpansion interface{}
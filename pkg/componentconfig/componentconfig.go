@@ -0,0 +1,174 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package componentconfig resolves the effective configuration of a Component deployed to an Environment.
+//
+// Configuration values are layered, from lowest to highest precedence:
+//   - the Component spec (replicas, resources and env)
+//   - the Environment configuration (env and rollout strategy)
+//   - the component configuration of the SnapshotEnvironmentBinding (replicas, resources, env and rollout strategy)
+//
+// A value defined in a higher layer overwrites the value of a lower layer. Resource requests and limits are merged per
+// resource name, and environment variables are merged per variable name. A rollout strategy is never merged: the
+// strategy of the highest layer defining one is used as a whole.
+package componentconfig
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Layer identifies the resource an effective configuration value was taken from.
+type Layer string
+
+const (
+	Layer_Component   Layer = "Component"
+	Layer_Environment Layer = "Environment"
+	Layer_Binding     Layer = "SnapshotEnvironmentBinding"
+)
+
+// ReplicasKey is the provenance key of the replicas value.
+const ReplicasKey = "replicas"
+
+// RolloutStrategyKey is the provenance key of the rollout strategy.
+const RolloutStrategyKey = "rolloutStrategy"
+
+// EnvKey returns the provenance key of the environment variable with the given name.
+func EnvKey(name string) string {
+	return "env." + name
+}
+
+// ResourceLimitKey returns the provenance key of the resource limit with the given name.
+func ResourceLimitKey(name corev1.ResourceName) string {
+	return "resources.limits." + string(name)
+}
+
+// ResourceRequestKey returns the provenance key of the resource request with the given name.
+func ResourceRequestKey(name corev1.ResourceName) string {
+	return "resources.requests." + string(name)
+}
+
+// EffectiveConfiguration is the merged configuration of a Component deployed to an Environment.
+type EffectiveConfiguration struct {
+	// Replicas is the number of replicas to deploy the component with, or nil if no layer defines it.
+	Replicas *int
+
+	// Resources are the compute resources required by the component.
+	Resources corev1.ResourceRequirements
+
+	// Env are the environment variables of the component, in the order they were first defined.
+	Env []corev1.EnvVar
+
+	// RolloutStrategy is the strategy to roll out new versions of the component with, or nil if no layer defines it.
+	RolloutStrategy *appstudiov1alpha1.RolloutStrategy
+
+	// Provenance maps the key of each value in the effective configuration (see ReplicasKey, EnvKey, ResourceLimitKey,
+	// ResourceRequestKey and RolloutStrategyKey) to the layer it was taken from.
+	Provenance map[string]Layer
+}
+
+// Resolve returns the effective configuration of the given Component. The environment and bindingComponent arguments
+// are optional, and may be nil if the Component is not (yet) bound to an Environment.
+func Resolve(component *appstudiov1alpha1.Component, environment *appstudiov1alpha1.Environment,
+	bindingComponent *appstudiov1alpha1.BindingComponent) EffectiveConfiguration {
+
+	r := resolver{
+		config: EffectiveConfiguration{Provenance: map[string]Layer{}},
+		envIdx: map[string]int{},
+	}
+
+	if component != nil {
+		r.setReplicas(component.Spec.Replicas, Layer_Component)
+		r.setResources(&component.Spec.Resources, Layer_Component)
+		for _, envVar := range component.Spec.Env {
+			r.setEnv(envVar, Layer_Component)
+		}
+	}
+
+	if environment != nil {
+		for _, pair := range environment.Spec.Configuration.Env {
+			r.setEnv(corev1.EnvVar{Name: pair.Name, Value: pair.Value}, Layer_Environment)
+		}
+		r.setRolloutStrategy(environment.Spec.Configuration.RolloutStrategy, Layer_Environment)
+	}
+
+	if bindingComponent != nil {
+		bindingConfig := bindingComponent.Configuration
+		r.setReplicas(bindingConfig.Replicas, Layer_Binding)
+		r.setResources(bindingConfig.Resources, Layer_Binding)
+		for _, pair := range bindingConfig.Env {
+			r.setEnv(corev1.EnvVar{Name: pair.Name, Value: pair.Value}, Layer_Binding)
+		}
+		r.setRolloutStrategy(bindingConfig.RolloutStrategy, Layer_Binding)
+	}
+
+	return r.config
+}
+
+// resolver accumulates the effective configuration, one layer at a time.
+type resolver struct {
+	config EffectiveConfiguration
+
+	// envIdx maps the name of each environment variable to its index in config.Env
+	envIdx map[string]int
+}
+
+func (r *resolver) setReplicas(replicas *int, layer Layer) {
+	if replicas == nil {
+		return
+	}
+	value := *replicas
+	r.config.Replicas = &value
+	r.config.Provenance[ReplicasKey] = layer
+}
+
+func (r *resolver) setResources(resources *corev1.ResourceRequirements, layer Layer) {
+	if resources == nil {
+		return
+	}
+	for name, quantity := range resources.Limits {
+		if r.config.Resources.Limits == nil {
+			r.config.Resources.Limits = corev1.ResourceList{}
+		}
+		r.config.Resources.Limits[name] = quantity.DeepCopy()
+		r.config.Provenance[ResourceLimitKey(name)] = layer
+	}
+	for name, quantity := range resources.Requests {
+		if r.config.Resources.Requests == nil {
+			r.config.Resources.Requests = corev1.ResourceList{}
+		}
+		r.config.Resources.Requests[name] = quantity.DeepCopy()
+		r.config.Provenance[ResourceRequestKey(name)] = layer
+	}
+}
+
+func (r *resolver) setEnv(envVar corev1.EnvVar, layer Layer) {
+	if idx, exists := r.envIdx[envVar.Name]; exists {
+		r.config.Env[idx] = *envVar.DeepCopy()
+	} else {
+		r.envIdx[envVar.Name] = len(r.config.Env)
+		r.config.Env = append(r.config.Env, *envVar.DeepCopy())
+	}
+	r.config.Provenance[EnvKey(envVar.Name)] = layer
+}
+
+func (r *resolver) setRolloutStrategy(strategy *appstudiov1alpha1.RolloutStrategy, layer Layer) {
+	if strategy == nil {
+		return
+	}
+	r.config.RolloutStrategy = strategy.DeepCopy()
+	r.config.Provenance[RolloutStrategyKey] = layer
+}
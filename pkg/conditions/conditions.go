@@ -0,0 +1,135 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions contains helpers to set and query the status conditions of the appstudio resources.
+//
+// Most resources use []metav1.Condition, while the v1alpha1 PromotionRun uses its own PromotionRunCondition type;
+// both are supported with the same semantics:
+//   - Setting a condition moves it to the end of the list, so that '.status.conditions[-1]' (as used by the printer
+//     columns) always refers to the most recently set condition.
+//   - The LastTransitionTime of a condition is only updated when its status changes.
+//   - The ObservedGeneration of a condition records the generation of the resource the condition was computed from,
+//     and the '*ForGeneration' helpers ignore conditions computed from an older generation.
+package conditions
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// now returns the time used for the LastTransitionTime and LastProbeTime of the conditions.
+var now = metav1.Now
+
+// Set adds or replaces the condition of the same type within the given conditions, and moves it to the end of the list.
+// If the LastTransitionTime of the new condition is not set, it is set to the current time when the status has
+// changed, and to the previous LastTransitionTime otherwise. Returns true if the conditions have changed, i.e. if the
+// condition was added, if its content differs from the condition it replaces, or if it was moved.
+func Set(conditions *[]metav1.Condition, newCondition metav1.Condition) bool {
+	if conditions == nil {
+		return false
+	}
+
+	changed := true
+	if existing := Get(*conditions, newCondition.Type); existing != nil {
+		if newCondition.LastTransitionTime.IsZero() {
+			if existing.Status == newCondition.Status {
+				newCondition.LastTransitionTime = existing.LastTransitionTime
+			} else {
+				newCondition.LastTransitionTime = now()
+			}
+		}
+		changed = !equalConditions(*existing, newCondition) || &(*conditions)[len(*conditions)-1] != existing
+		Remove(conditions, newCondition.Type)
+	} else if newCondition.LastTransitionTime.IsZero() {
+		newCondition.LastTransitionTime = now()
+	}
+
+	*conditions = append(*conditions, newCondition)
+	return changed
+}
+
+// SetForObject sets the given condition like Set, with its ObservedGeneration set to the generation of the given object.
+func SetForObject(obj metav1.Object, conditions *[]metav1.Condition, newCondition metav1.Condition) bool {
+	newCondition.ObservedGeneration = obj.GetGeneration()
+	return Set(conditions, newCondition)
+}
+
+// Get returns the condition of the given type, or nil if there is none.
+func Get(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// GetForGeneration returns the condition of the given type, or nil if there is none or if it was computed from a
+// generation older than the given one.
+func GetForGeneration(conditions []metav1.Condition, conditionType string, generation int64) *metav1.Condition {
+	condition := Get(conditions, conditionType)
+	if condition == nil || condition.ObservedGeneration < generation {
+		return nil
+	}
+	return condition
+}
+
+// Last returns the most recently set condition, or nil if there is none.
+func Last(conditions []metav1.Condition) *metav1.Condition {
+	if len(conditions) == 0 {
+		return nil
+	}
+	return &conditions[len(conditions)-1]
+}
+
+// Remove removes the condition of the given type. Returns true if a condition was removed.
+func Remove(conditions *[]metav1.Condition, conditionType string) bool {
+	if conditions == nil {
+		return false
+	}
+	for i := range *conditions {
+		if (*conditions)[i].Type == conditionType {
+			*conditions = append((*conditions)[:i], (*conditions)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// IsTrue returns true if the condition of the given type exists and its status is True.
+func IsTrue(conditions []metav1.Condition, conditionType string) bool {
+	return hasStatus(Get(conditions, conditionType), metav1.ConditionTrue)
+}
+
+// IsFalse returns true if the condition of the given type exists and its status is False.
+func IsFalse(conditions []metav1.Condition, conditionType string) bool {
+	return hasStatus(Get(conditions, conditionType), metav1.ConditionFalse)
+}
+
+// IsTrueForGeneration returns true if the condition of the given type exists, its status is True, and it was computed
+// from the given generation or a newer one.
+func IsTrueForGeneration(conditions []metav1.Condition, conditionType string, generation int64) bool {
+	return hasStatus(GetForGeneration(conditions, conditionType, generation), metav1.ConditionTrue)
+}
+
+func hasStatus(condition *metav1.Condition, status metav1.ConditionStatus) bool {
+	return condition != nil && condition.Status == status
+}
+
+// equalConditions compares two conditions, using the semantic equality of their LastTransitionTime.
+func equalConditions(a, b metav1.Condition) bool {
+	return a.Type == b.Type && a.Status == b.Status && a.Reason == b.Reason && a.Message == b.Message &&
+		a.ObservedGeneration == b.ObservedGeneration && a.LastTransitionTime.Equal(&b.LastTransitionTime)
+}
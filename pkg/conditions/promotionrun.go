@@ -0,0 +1,126 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// SetPromotionRunCondition is the equivalent of Set for the v1alpha1 PromotionRun conditions. In addition, the
+// LastProbeTime of the new condition is set to the current time if it is not set.
+func SetPromotionRunCondition(conditions *[]appstudiov1alpha1.PromotionRunCondition,
+	newCondition appstudiov1alpha1.PromotionRunCondition) bool {
+
+	if conditions == nil {
+		return false
+	}
+
+	changed := true
+	if existing := GetPromotionRunCondition(*conditions, newCondition.Type); existing != nil {
+		if newCondition.LastTransitionTime == nil {
+			if existing.Status == newCondition.Status {
+				newCondition.LastTransitionTime = existing.LastTransitionTime
+			} else {
+				transitionTime := now()
+				newCondition.LastTransitionTime = &transitionTime
+			}
+		}
+		changed = !equalPromotionRunConditions(*existing, newCondition) || &(*conditions)[len(*conditions)-1] != existing
+		RemovePromotionRunCondition(conditions, newCondition.Type)
+	} else if newCondition.LastTransitionTime == nil {
+		transitionTime := now()
+		newCondition.LastTransitionTime = &transitionTime
+	}
+	if newCondition.LastProbeTime.IsZero() {
+		newCondition.LastProbeTime = now()
+	}
+
+	*conditions = append(*conditions, newCondition)
+	return changed
+}
+
+// SetPromotionRunConditionForObject sets the given condition like SetPromotionRunCondition, with its
+// ObservedGeneration set to the generation of the given PromotionRun.
+func SetPromotionRunConditionForObject(promotionRun *appstudiov1alpha1.PromotionRun,
+	newCondition appstudiov1alpha1.PromotionRunCondition) bool {
+
+	newCondition.ObservedGeneration = promotionRun.Generation
+	return SetPromotionRunCondition(&promotionRun.Status.Conditions, newCondition)
+}
+
+// GetPromotionRunCondition returns the condition of the given type, or nil if there is none.
+func GetPromotionRunCondition(conditions []appstudiov1alpha1.PromotionRunCondition,
+	conditionType appstudiov1alpha1.PromotionRunConditionType) *appstudiov1alpha1.PromotionRunCondition {
+
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// GetPromotionRunConditionForGeneration returns the condition of the given type, or nil if there is none or if it was
+// computed from a generation older than the given one.
+func GetPromotionRunConditionForGeneration(conditions []appstudiov1alpha1.PromotionRunCondition,
+	conditionType appstudiov1alpha1.PromotionRunConditionType, generation int64) *appstudiov1alpha1.PromotionRunCondition {
+
+	condition := GetPromotionRunCondition(conditions, conditionType)
+	if condition == nil || condition.ObservedGeneration < generation {
+		return nil
+	}
+	return condition
+}
+
+// RemovePromotionRunCondition removes the condition of the given type. Returns true if a condition was removed.
+func RemovePromotionRunCondition(conditions *[]appstudiov1alpha1.PromotionRunCondition,
+	conditionType appstudiov1alpha1.PromotionRunConditionType) bool {
+
+	if conditions == nil {
+		return false
+	}
+	for i := range *conditions {
+		if (*conditions)[i].Type == conditionType {
+			*conditions = append((*conditions)[:i], (*conditions)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// IsPromotionRunConditionTrue returns true if the condition of the given type exists and its status is True.
+func IsPromotionRunConditionTrue(conditions []appstudiov1alpha1.PromotionRunCondition,
+	conditionType appstudiov1alpha1.PromotionRunConditionType) bool {
+
+	condition := GetPromotionRunCondition(conditions, conditionType)
+	return condition != nil && condition.Status == appstudiov1alpha1.PromotionRunConditionStatusTrue
+}
+
+// IsPromotionRunConditionTrueForGeneration returns true if the condition of the given type exists, its status is
+// True, and it was computed from the given generation or a newer one.
+func IsPromotionRunConditionTrueForGeneration(conditions []appstudiov1alpha1.PromotionRunCondition,
+	conditionType appstudiov1alpha1.PromotionRunConditionType, generation int64) bool {
+
+	condition := GetPromotionRunConditionForGeneration(conditions, conditionType, generation)
+	return condition != nil && condition.Status == appstudiov1alpha1.PromotionRunConditionStatusTrue
+}
+
+// equalPromotionRunConditions compares two conditions, ignoring their LastProbeTime.
+func equalPromotionRunConditions(a, b appstudiov1alpha1.PromotionRunCondition) bool {
+	return a.Type == b.Type && a.Status == b.Status && a.Reason == b.Reason && a.Message == b.Message &&
+		a.ObservedGeneration == b.ObservedGeneration && a.LastTransitionTime.Equal(b.LastTransitionTime)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deploymenthistory records the Snapshots deployed by a SnapshotEnvironmentBinding in its
+// status.deploymentHistory, so that rollback and audit tools know what was deployed to an Environment, when, and why.
+//
+// An entry is started each time the binding's spec.snapshot changes, and completed once all of the components of the
+// Snapshot are deployed. Only the most recent MaxDeploymentHistory entries are kept.
+package deploymenthistory
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Source identifies what caused a deployment.
+type Source struct {
+	// PromotionRun is the name of the PromotionRun that caused the deployment, if any
+	PromotionRun string
+
+	// DeployedBy identifies the user or controller that caused the deployment, if known
+	DeployedBy string
+}
+
+// Latest returns the most recent entry of the deployment history of the binding, or nil if the history is empty.
+func Latest(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) *appstudiov1alpha1.DeploymentHistoryEntry {
+	history := binding.Status.DeploymentHistory
+	if len(history) == 0 {
+		return nil
+	}
+	return &history[len(history)-1]
+}
+
+// Start records the start of the deployment of the binding's spec.snapshot, caused by the given source. Nothing is
+// recorded if the most recent entry of the history is already for that Snapshot. Returns true if the history has changed.
+func Start(binding *appstudiov1alpha1.SnapshotEnvironmentBinding, source Source) bool {
+	snapshot := binding.Spec.Snapshot
+	if snapshot == "" {
+		return false
+	}
+	if latest := Latest(binding); latest != nil && latest.Snapshot == snapshot {
+		return false
+	}
+
+	history := append(binding.Status.DeploymentHistory, appstudiov1alpha1.DeploymentHistoryEntry{
+		Snapshot:     snapshot,
+		PromotionRun: source.PromotionRun,
+		DeployedBy:   source.DeployedBy,
+		StartTime:    metav1.Now(),
+	})
+	if len(history) > appstudiov1alpha1.MaxDeploymentHistory {
+		history = history[len(history)-appstudiov1alpha1.MaxDeploymentHistory:]
+	}
+	binding.Status.DeploymentHistory = history
+	return true
+}
+
+// Complete records the completion of the deployment of the binding's spec.snapshot, with the commit ID each of its
+// components was deployed from. The commit ID of a component is taken from its GitOpsDeployment, or from the GitOps
+// repository status of the component if its GitOpsDeployment does not report one.
+// Nothing is recorded if the most recent entry of the history is not for that Snapshot, or has already completed.
+// Returns true if the history has changed.
+func Complete(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) bool {
+	latest := Latest(binding)
+	if latest == nil || latest.Snapshot != binding.Spec.Snapshot || latest.CompletionTime != nil {
+		return false
+	}
+
+	commitIDs := map[string]string{}
+	for _, component := range binding.Status.Components {
+		commitIDs[component.Name] = component.GitOpsRepository.CommitID
+	}
+	for _, deployment := range binding.Status.GitOpsDeployments {
+		if deployment.GitOpsDeploymentCommitID != "" {
+			commitIDs[deployment.ComponentName] = deployment.GitOpsDeploymentCommitID
+		}
+	}
+
+	latest.Components = nil
+	for _, component := range binding.Spec.Components {
+		latest.Components = append(latest.Components, appstudiov1alpha1.DeploymentHistoryComponent{
+			Name:     component.Name,
+			CommitID: commitIDs[component.Name],
+		})
+	}
+	now := metav1.Now()
+	latest.CompletionTime = &now
+	return true
+}
+
+// PreviousSnapshot returns the name of the Snapshot that was deployed by the binding before its current spec.snapshot,
+// i.e. the Snapshot of the most recent completed entry of the history for another Snapshot. It returns an empty string
+// if there is none. This is the Snapshot a rollback to the previous Snapshot deploys, see promotionrun.PlanInput.
+func PreviousSnapshot(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) string {
+	history := binding.Status.DeploymentHistory
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Snapshot != binding.Spec.Snapshot && history[i].CompletionTime != nil {
+			return history[i].Snapshot
+		}
+	}
+	return ""
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deploymentschedule evaluates the deployment schedule of an Environment: whether the Environment may be
+// promoted to at a given time, and if not, when it may be promoted to next.
+package deploymentschedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxIterations bounds the search for the next allowed time, which alternates between skipping blackout periods and
+// skipping to the next window.
+const maxIterations = 1000
+
+var weekdays = map[appstudiov1alpha1.Weekday]time.Weekday{
+	appstudiov1alpha1.Weekday_Monday:    time.Monday,
+	appstudiov1alpha1.Weekday_Tuesday:   time.Tuesday,
+	appstudiov1alpha1.Weekday_Wednesday: time.Wednesday,
+	appstudiov1alpha1.Weekday_Thursday:  time.Thursday,
+	appstudiov1alpha1.Weekday_Friday:    time.Friday,
+	appstudiov1alpha1.Weekday_Saturday:  time.Saturday,
+	appstudiov1alpha1.Weekday_Sunday:    time.Sunday,
+}
+
+// Evaluation is the result of evaluating a deployment schedule at a given time.
+type Evaluation struct {
+	// Allowed is true if promotions are allowed at the evaluated time
+	Allowed bool
+
+	// Reason describes why promotions are not allowed at the evaluated time; empty if they are
+	Reason string
+
+	// NextAllowed is the earliest time, at or after the evaluated time, at which promotions are allowed. It is the
+	// evaluated time itself if promotions are allowed, and the zero time if they are never allowed again.
+	NextAllowed time.Time
+}
+
+// Evaluate evaluates the given deployment schedule at time t. A nil schedule allows promotions at any time. An error
+// is returned if the schedule is invalid.
+func Evaluate(schedule *appstudiov1alpha1.DeploymentSchedule, t time.Time) (*Evaluation, error) {
+	if schedule == nil {
+		return &Evaluation{Allowed: true, NextAllowed: t}, nil
+	}
+	s, err := parse(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluation := &Evaluation{}
+	if blackout := s.blackoutAt(t); blackout != nil {
+		evaluation.Reason = fmt.Sprintf("promotions are not allowed during the blackout period %s", describe(blackout))
+	} else if len(s.windows) > 0 && !s.inWindow(t) {
+		evaluation.Reason = "promotions are not allowed outside of the deployment windows"
+	} else {
+		evaluation.Allowed = true
+		evaluation.NextAllowed = t
+		return evaluation, nil
+	}
+
+	evaluation.NextAllowed = s.nextAllowed(t)
+	return evaluation, nil
+}
+
+// IsAllowed returns true if the given Environment may be promoted to at time t.
+func IsAllowed(env *appstudiov1alpha1.Environment, t time.Time) (bool, error) {
+	evaluation, err := Evaluate(env.Spec.DeploymentSchedule, t)
+	if err != nil {
+		return false, err
+	}
+	return evaluation.Allowed, nil
+}
+
+// Validate returns an error if the given deployment schedule cannot be evaluated.
+func Validate(schedule *appstudiov1alpha1.DeploymentSchedule) error {
+	if errs := ValidateFields(schedule, field.NewPath("deploymentSchedule")); len(errs) > 0 {
+		return fmt.Errorf(appstudiov1alpha1.InvalidDeploymentSchedule, errs.ToAggregate())
+	}
+	return nil
+}
+
+// ValidateFields returns an error for each field of the given deployment schedule, at fldPath, which prevents the
+// schedule from being evaluated.
+func ValidateFields(schedule *appstudiov1alpha1.DeploymentSchedule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), schedule.TimeZone, err.Error()))
+		}
+	}
+
+	for i, w := range schedule.Windows {
+		windowPath := fldPath.Child("windows").Index(i)
+		for j, day := range w.Days {
+			if _, valid := weekdays[day]; !valid {
+				allErrs = append(allErrs, field.NotSupported(windowPath.Child("days").Index(j), day, weekdayNames()))
+			}
+		}
+		if _, err := parseTimeOfDay(w.Start); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("start"), w.Start, err.Error()))
+		}
+		if _, err := parseTimeOfDay(w.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("end"), w.End, err.Error()))
+		}
+	}
+
+	for i, blackout := range schedule.Blackouts {
+		if !blackout.End.After(blackout.Start.Time) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("blackouts").Index(i).Child("end"), blackout.End.UTC().Format(time.RFC3339),
+				"must be after the start of the blackout period"))
+		}
+	}
+
+	return allErrs
+}
+
+// window is a parsed DeploymentWindow.
+type window struct {
+	days       map[time.Weekday]bool
+	start, end time.Duration
+}
+
+// parsedSchedule is a parsed DeploymentSchedule.
+type parsedSchedule struct {
+	location  *time.Location
+	windows   []window
+	blackouts []appstudiov1alpha1.BlackoutPeriod
+}
+
+// parse parses the given deployment schedule, returning an error if it is not valid.
+func parse(schedule *appstudiov1alpha1.DeploymentSchedule) (*parsedSchedule, error) {
+	if err := Validate(schedule); err != nil {
+		return nil, err
+	}
+
+	s := &parsedSchedule{location: time.UTC, blackouts: schedule.Blackouts}
+	if schedule.TimeZone != "" {
+		s.location, _ = time.LoadLocation(schedule.TimeZone)
+	}
+	for _, w := range schedule.Windows {
+		parsed := window{days: map[time.Weekday]bool{}}
+		for _, day := range w.Days {
+			parsed.days[weekdays[day]] = true
+		}
+		parsed.start, _ = parseTimeOfDay(w.Start)
+		parsed.end, _ = parseTimeOfDay(w.End)
+		if parsed.end <= parsed.start {
+			parsed.end += 24 * time.Hour
+		}
+		s.windows = append(s.windows, parsed)
+	}
+
+	return s, nil
+}
+
+// weekdayNames returns the supported names of the days of the week.
+func weekdayNames() []string {
+	names := make([]string, 0, len(weekdays))
+	for day := range weekdays {
+		names = append(names, string(day))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseTimeOfDay parses a time of the day in the HH:MM format, as a duration since midnight.
+func parseTimeOfDay(value string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, errors.New("must be in the HH:MM format")
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// blackoutAt returns the blackout period which contains t, or nil if there is none.
+func (s *parsedSchedule) blackoutAt(t time.Time) *appstudiov1alpha1.BlackoutPeriod {
+	for i := range s.blackouts {
+		blackout := &s.blackouts[i]
+		if !t.Before(blackout.Start.Time) && t.Before(blackout.End.Time) {
+			return blackout
+		}
+	}
+	return nil
+}
+
+// inWindow returns true if t is within one of the windows.
+func (s *parsedSchedule) inWindow(t time.Time) bool {
+	found := false
+	s.forEachWindow(t, func(start, end time.Time) bool {
+		found = !t.Before(start) && t.Before(end)
+		return !found
+	})
+	return found
+}
+
+// nextWindowStart returns the start of the first window which starts after t.
+func (s *parsedSchedule) nextWindowStart(t time.Time) (time.Time, bool) {
+	var next time.Time
+	s.forEachWindow(t, func(start, end time.Time) bool {
+		if start.After(t) && (next.IsZero() || start.Before(next)) {
+			next = start
+		}
+		return true
+	})
+	return next, !next.IsZero()
+}
+
+// forEachWindow calls fn with the start and end of each occurrence of the windows which may contain t or start
+// within the following week, until fn returns false.
+func (s *parsedSchedule) forEachWindow(t time.Time, fn func(start, end time.Time) bool) {
+	local := t.In(s.location)
+	// windows last at most a day, so an occurrence containing t started at most one day before t
+	for offset := -1; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, s.location)
+		for _, w := range s.windows {
+			if len(w.days) > 0 && !w.days[day.Weekday()] {
+				continue
+			}
+			start := atTimeOfDay(day, w.start)
+			end := atTimeOfDay(day, w.end)
+			if !fn(start, end) {
+				return
+			}
+		}
+	}
+}
+
+// atTimeOfDay returns the time at the given duration since the midnight of the given day, in the day's time zone.
+// Computing the time from the calendar fields keeps the wall clock time correct across daylight saving changes.
+func atTimeOfDay(day time.Time, sinceMidnight time.Duration) time.Time {
+	days := int(sinceMidnight / (24 * time.Hour))
+	sinceMidnight -= time.Duration(days) * 24 * time.Hour
+	return time.Date(day.Year(), day.Month(), day.Day()+days,
+		int(sinceMidnight/time.Hour), int(sinceMidnight%time.Hour/time.Minute), 0, 0, day.Location())
+}
+
+// nextAllowed returns the earliest time at or after t at which promotions are allowed, or the zero time if there is
+// none.
+func (s *parsedSchedule) nextAllowed(t time.Time) time.Time {
+	candidate := t
+	for i := 0; i < maxIterations; i++ {
+		if blackout := s.blackoutAt(candidate); blackout != nil {
+			candidate = blackout.End.Time
+			continue
+		}
+		if len(s.windows) > 0 && !s.inWindow(candidate) {
+			next, found := s.nextWindowStart(candidate)
+			if !found {
+				return time.Time{}
+			}
+			candidate = next
+			continue
+		}
+		return candidate
+	}
+	return time.Time{}
+}
+
+func describe(blackout *appstudiov1alpha1.BlackoutPeriod) string {
+	period := fmt.Sprintf("from %s to %s", blackout.Start.UTC().Format(time.RFC3339), blackout.End.UTC().Format(time.RFC3339))
+	if blackout.Name == "" {
+		return period
+	}
+	return fmt.Sprintf("%q (%s)", blackout.Name, period)
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package environmentgraph builds the promotion graph of the Environments in a namespace.
+//
+// Each Environment may reference a single parent via spec.parentEnvironment, so the graph is a forest of trees, rooted at
+// the Environments without a parent. When automated promotion is enabled, a successful promotion to an Environment causes
+// its children to be promoted to, one tree depth at a time.
+package environmentgraph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Graph is the promotion graph of a set of Environments.
+type Graph struct {
+	// environments maps the name of each Environment to the Environment itself
+	environments map[string]*appstudiov1alpha1.Environment
+
+	// children maps the name of each Environment to the sorted names of the Environments that reference it as a parent
+	children map[string][]string
+
+	// names is the sorted list of all Environment names in the graph
+	names []string
+}
+
+// CycleError is returned when the parent references of a set of Environments form a cycle.
+type CycleError struct {
+	// Environments are the names of the Environments in the cycle, in parent to child order
+	Environments []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("environment parent references form a cycle: %s", strings.Join(append(e.Environments, e.Environments[0]), " -> "))
+}
+
+// DanglingParentError is returned when an Environment references a parent Environment that does not exist.
+type DanglingParentError struct {
+	// Environment is the name of the Environment with the dangling reference
+	Environment string
+
+	// ParentEnvironment is the name of the parent Environment that does not exist
+	ParentEnvironment string
+}
+
+func (e *DanglingParentError) Error() string {
+	return fmt.Sprintf("environment %q references parent environment %q, which does not exist", e.Environment, e.ParentEnvironment)
+}
+
+// New builds the promotion graph of the Environments in envList.
+func New(envList *appstudiov1alpha1.EnvironmentList) *Graph {
+	g := &Graph{
+		environments: map[string]*appstudiov1alpha1.Environment{},
+		children:     map[string][]string{},
+	}
+
+	for i := range envList.Items {
+		env := &envList.Items[i]
+		g.environments[env.Name] = env
+		g.names = append(g.names, env.Name)
+	}
+	sort.Strings(g.names)
+
+	for _, name := range g.names {
+		if parent := g.parentOf(name); parent != "" {
+			g.children[parent] = append(g.children[parent], name)
+		}
+	}
+
+	return g
+}
+
+// Environment returns the Environment with the given name, or nil if it is not part of the graph.
+func (g *Graph) Environment(name string) *appstudiov1alpha1.Environment {
+	return g.environments[name]
+}
+
+// Names returns the sorted names of all Environments in the graph.
+func (g *Graph) Names() []string {
+	return append([]string(nil), g.names...)
+}
+
+// Parent returns the name of the parent of the given Environment, or an empty string if the Environment has no parent,
+// or its parent does not exist.
+func (g *Graph) Parent(name string) string {
+	return g.parentOf(name)
+}
+
+// Children returns the sorted names of the Environments which reference the given Environment as their parent.
+func (g *Graph) Children(name string) []string {
+	return append([]string(nil), g.children[name]...)
+}
+
+// Roots returns the sorted names of the Environments that have no parent. Environments with a dangling parent reference
+// are considered roots.
+func (g *Graph) Roots() []string {
+	var roots []string
+	for _, name := range g.names {
+		if g.parentOf(name) == "" {
+			roots = append(roots, name)
+		}
+	}
+	return roots
+}
+
+// DanglingParents returns an error for each Environment that references a parent Environment that does not exist.
+func (g *Graph) DanglingParents() []*DanglingParentError {
+	var errs []*DanglingParentError
+	for _, name := range g.names {
+		parent := g.environments[name].Spec.ParentEnvironment
+		if parent == "" {
+			continue
+		}
+		if _, exists := g.environments[parent]; !exists {
+			errs = append(errs, &DanglingParentError{Environment: name, ParentEnvironment: parent})
+		}
+	}
+	return errs
+}
+
+// Cycles returns an error for each cycle formed by the parent references of the Environments.
+func (g *Graph) Cycles() []*CycleError {
+	var errs []*CycleError
+
+	// Since each Environment has at most one parent, every cycle can be found by following parent references.
+	visited := map[string]bool{}
+	for _, name := range g.names {
+		if visited[name] {
+			continue
+		}
+
+		onPath := map[string]int{}
+		var path []string
+		current := name
+		for current != "" && !visited[current] {
+			visited[current] = true
+			onPath[current] = len(path)
+			path = append(path, current)
+			current = g.parentOf(current)
+		}
+
+		if idx, found := onPath[current]; found && current != "" {
+			cycle := path[idx:]
+			// report the cycle in parent to child order, starting from its lowest name, so the result is stable
+			reversed := make([]string, len(cycle))
+			for i := range cycle {
+				reversed[i] = cycle[len(cycle)-1-i]
+			}
+			lowest := 0
+			for i := range reversed {
+				if reversed[i] < reversed[lowest] {
+					lowest = i
+				}
+			}
+			errs = append(errs, &CycleError{Environments: append(reversed[lowest:], reversed[:lowest]...)})
+		}
+	}
+
+	return errs
+}
+
+// Validate returns an aggregate of all dangling parent and cycle errors in the graph, or nil if there are none.
+func (g *Graph) Validate() error {
+	var errs []error
+	for _, err := range g.DanglingParents() {
+		errs = append(errs, err)
+	}
+	for _, err := range g.Cycles() {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// TopologicalOrder returns the names of all Environments, ordered such that each Environment comes after its parent.
+// Environments at the same depth are sorted by name. An error is returned if the graph contains a cycle.
+func (g *Graph) TopologicalOrder() ([]string, error) {
+	levels, err := g.Levels()
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	for _, level := range levels {
+		order = append(order, level...)
+	}
+	return order, nil
+}
+
+// Levels returns the names of all Environments grouped by their depth in the graph: the first level contains the roots,
+// the second level contains their children, and so on. An error is returned if the graph contains a cycle.
+func (g *Graph) Levels() ([][]string, error) {
+	if err := g.cycleError(); err != nil {
+		return nil, err
+	}
+	return g.levelsFrom(g.Roots()), nil
+}
+
+// LevelsFrom returns the names of the given Environment and its descendants, grouped by their depth relative to the
+// given Environment. This matches the order in which an automated promotion starting at the given Environment proceeds.
+// An error is returned if the Environment does not exist, or if it is part of a cycle.
+func (g *Graph) LevelsFrom(name string) ([][]string, error) {
+	if _, exists := g.environments[name]; !exists {
+		return nil, fmt.Errorf("environment %q does not exist", name)
+	}
+	// The descendants of an Environment can only be part of a cycle if the Environment itself is.
+	for _, cycle := range g.Cycles() {
+		for _, cycleEnv := range cycle.Environments {
+			if cycleEnv == name {
+				return nil, cycle
+			}
+		}
+	}
+	return g.levelsFrom([]string{name}), nil
+}
+
+func (g *Graph) levelsFrom(level []string) [][]string {
+	var levels [][]string
+	for len(level) > 0 {
+		levels = append(levels, level)
+
+		var next []string
+		for _, name := range level {
+			next = append(next, g.children[name]...)
+		}
+		sort.Strings(next)
+		level = next
+	}
+	return levels
+}
+
+func (g *Graph) cycleError() error {
+	var errs []error
+	for _, err := range g.Cycles() {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// parentOf returns the name of the parent of an Environment, if the parent exists in the graph.
+func (g *Graph) parentOf(name string) string {
+	env, exists := g.environments[name]
+	if !exists {
+		return ""
+	}
+	if _, exists := g.environments[env.Spec.ParentEnvironment]; !exists {
+		return ""
+	}
+	return env.Spec.ParentEnvironment
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nudgegraph builds the build nudge graph of the Components in a namespace.
+//
+// Each Component may reference the Components its successful builds nudge via spec.build-nudges-ref, and records the
+// Components which nudge it in status.build-nudged-by. A Component may only nudge Components of the same Application,
+// and the nudge references must not form a cycle, since the builds would otherwise nudge each other endlessly.
+package nudgegraph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Graph is the build nudge graph of a set of Components. Only references to existing Components of the same
+// Application are part of the graph; the other references are reported by DanglingReferences and
+// CrossApplicationReferences.
+type Graph struct {
+	// components maps the name of each Component to the Component itself
+	components map[string]*appstudiov1alpha1.Component
+
+	// nudges maps the name of each Component to the sorted names of the Components it nudges
+	nudges map[string][]string
+
+	// nudgedBy maps the name of each Component to the sorted names of the Components that nudge it
+	nudgedBy map[string][]string
+
+	// names is the sorted list of all Component names in the graph
+	names []string
+}
+
+// CycleError is returned when the nudge references of a set of Components form a cycle.
+type CycleError struct {
+	// Components are the sorted names of the Components which nudge each other, directly or transitively
+	Components []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("build nudge references form a cycle between components: %s", strings.Join(e.Components, ", "))
+}
+
+// DanglingReferenceError is returned when a Component nudges a Component that does not exist.
+type DanglingReferenceError struct {
+	// Component is the name of the Component with the dangling reference
+	Component string
+
+	// NudgedComponent is the name of the nudged Component that does not exist
+	NudgedComponent string
+}
+
+func (e *DanglingReferenceError) Error() string {
+	return fmt.Sprintf("component %q nudges component %q, which does not exist", e.Component, e.NudgedComponent)
+}
+
+// CrossApplicationReferenceError is returned when a Component nudges a Component of another Application.
+type CrossApplicationReferenceError struct {
+	// Component is the name of the Component with the cross-application reference
+	Component string
+
+	// Application is the name of the Application of the Component
+	Application string
+
+	// NudgedComponent is the name of the nudged Component
+	NudgedComponent string
+
+	// NudgedApplication is the name of the Application of the nudged Component
+	NudgedApplication string
+}
+
+func (e *CrossApplicationReferenceError) Error() string {
+	return fmt.Sprintf("component %q of application %q nudges component %q of application %q, but components may only nudge components of the same application",
+		e.Component, e.Application, e.NudgedComponent, e.NudgedApplication)
+}
+
+// New builds the build nudge graph of the Components in componentList.
+func New(componentList *appstudiov1alpha1.ComponentList) *Graph {
+	g := &Graph{
+		components: map[string]*appstudiov1alpha1.Component{},
+		nudges:     map[string][]string{},
+		nudgedBy:   map[string][]string{},
+	}
+
+	for i := range componentList.Items {
+		component := &componentList.Items[i]
+		g.components[component.Name] = component
+		g.names = append(g.names, component.Name)
+	}
+	sort.Strings(g.names)
+
+	for _, name := range g.names {
+		for _, nudged := range g.validReferences(name) {
+			g.nudges[name] = append(g.nudges[name], nudged)
+			g.nudgedBy[nudged] = append(g.nudgedBy[nudged], name)
+		}
+		sort.Strings(g.nudges[name])
+	}
+
+	return g
+}
+
+// Component returns the Component with the given name, or nil if it is not part of the graph.
+func (g *Graph) Component(name string) *appstudiov1alpha1.Component {
+	return g.components[name]
+}
+
+// Names returns the sorted names of all Components in the graph.
+func (g *Graph) Names() []string {
+	return append([]string(nil), g.names...)
+}
+
+// Nudges returns the sorted names of the Components nudged by the given Component.
+func (g *Graph) Nudges(name string) []string {
+	return append([]string(nil), g.nudges[name]...)
+}
+
+// NudgedBy returns the sorted names of the Components which nudge the given Component. This is the expected value of
+// the status.build-nudged-by field of the Component.
+func (g *Graph) NudgedBy(name string) []string {
+	return append([]string(nil), g.nudgedBy[name]...)
+}
+
+// OutdatedNudgedBy returns the sorted names of the Components whose status.build-nudged-by field does not match the
+// expected value returned by NudgedBy. The order of the names within the field is not significant.
+func (g *Graph) OutdatedNudgedBy() []string {
+	var outdated []string
+	for _, name := range g.names {
+		actual := append([]string(nil), g.components[name].Status.BuildNudgedBy...)
+		sort.Strings(actual)
+		expected := g.nudgedBy[name]
+		if len(actual) != len(expected) {
+			outdated = append(outdated, name)
+			continue
+		}
+		for i := range actual {
+			if actual[i] != expected[i] {
+				outdated = append(outdated, name)
+				break
+			}
+		}
+	}
+	return outdated
+}
+
+// DanglingReferences returns an error for each reference to a Component that does not exist.
+func (g *Graph) DanglingReferences() []*DanglingReferenceError {
+	var errs []*DanglingReferenceError
+	for _, name := range g.names {
+		for _, nudged := range g.components[name].Spec.BuildNudgesRef {
+			if _, exists := g.components[nudged]; !exists {
+				errs = append(errs, &DanglingReferenceError{Component: name, NudgedComponent: nudged})
+			}
+		}
+	}
+	return errs
+}
+
+// CrossApplicationReferences returns an error for each reference to a Component of another Application.
+func (g *Graph) CrossApplicationReferences() []*CrossApplicationReferenceError {
+	var errs []*CrossApplicationReferenceError
+	for _, name := range g.names {
+		component := g.components[name]
+		for _, nudged := range component.Spec.BuildNudgesRef {
+			nudgedComponent, exists := g.components[nudged]
+			if exists && nudgedComponent.Spec.Application != component.Spec.Application {
+				errs = append(errs, &CrossApplicationReferenceError{
+					Component:         name,
+					Application:       component.Spec.Application,
+					NudgedComponent:   nudged,
+					NudgedApplication: nudgedComponent.Spec.Application,
+				})
+			}
+		}
+	}
+	return errs
+}
+
+// Cycles returns an error for each set of Components which nudge each other, directly or transitively. A Component
+// which nudges itself forms a cycle on its own.
+func (g *Graph) Cycles() []*CycleError {
+	var errs []*CycleError
+	for _, scc := range g.stronglyConnectedComponents() {
+		if len(scc) > 1 || g.nudgesItself(scc[0]) {
+			errs = append(errs, &CycleError{Components: scc})
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Components[0] < errs[j].Components[0]
+	})
+	return errs
+}
+
+// Validate returns an aggregate of all dangling reference, cross-application reference and cycle errors in the graph,
+// or nil if there are none.
+func (g *Graph) Validate() error {
+	var errs []error
+	for _, err := range g.DanglingReferences() {
+		errs = append(errs, err)
+	}
+	for _, err := range g.CrossApplicationReferences() {
+		errs = append(errs, err)
+	}
+	for _, err := range g.Cycles() {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// RebuildOrder returns the names of all Components, ordered such that each Component comes after all Components which
+// nudge it. An error is returned if the graph contains a cycle.
+func (g *Graph) RebuildOrder() ([]string, error) {
+	levels, err := g.Levels()
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	for _, level := range levels {
+		order = append(order, level...)
+	}
+	return order, nil
+}
+
+// Levels returns the names of all Components grouped into levels which can be rebuilt in parallel: the first level
+// contains the Components which are not nudged by any other Component, and each following level contains the
+// Components whose nudging Components are all part of the previous levels. An error is returned if the graph contains
+// a cycle.
+func (g *Graph) Levels() ([][]string, error) {
+	if err := g.cycleError(); err != nil {
+		return nil, err
+	}
+	return g.levelsOf(g.names), nil
+}
+
+// LevelsFrom returns the names of the Components which are rebuilt, directly or transitively, after a build of the
+// given Component, grouped into levels like Levels. The first level only contains the given Component. An error is
+// returned if the Component does not exist, or if a cycle is reachable from it.
+func (g *Graph) LevelsFrom(name string) ([][]string, error) {
+	if _, exists := g.components[name]; !exists {
+		return nil, fmt.Errorf("component %q does not exist", name)
+	}
+
+	reachable := map[string]bool{name: true}
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, nudged := range g.nudges[current] {
+			if !reachable[nudged] {
+				reachable[nudged] = true
+				queue = append(queue, nudged)
+			}
+		}
+	}
+	for _, cycle := range g.Cycles() {
+		if reachable[cycle.Components[0]] {
+			return nil, cycle
+		}
+	}
+
+	var names []string
+	for _, n := range g.names {
+		if reachable[n] {
+			names = append(names, n)
+		}
+	}
+	return g.levelsOf(names), nil
+}
+
+// levelsOf groups the given acyclic set of Components into levels, only considering the references between them.
+func (g *Graph) levelsOf(names []string) [][]string {
+	inSet := map[string]bool{}
+	for _, name := range names {
+		inSet[name] = true
+	}
+
+	remaining := map[string]int{}
+	var level []string
+	for _, name := range names {
+		for _, nudger := range g.nudgedBy[name] {
+			if inSet[nudger] {
+				remaining[name]++
+			}
+		}
+		if remaining[name] == 0 {
+			level = append(level, name)
+		}
+	}
+
+	var levels [][]string
+	for len(level) > 0 {
+		levels = append(levels, level)
+
+		var next []string
+		for _, name := range level {
+			for _, nudged := range g.nudges[name] {
+				if !inSet[nudged] {
+					continue
+				}
+				remaining[nudged]--
+				if remaining[nudged] == 0 {
+					next = append(next, nudged)
+				}
+			}
+		}
+		sort.Strings(next)
+		level = next
+	}
+	return levels
+}
+
+// stronglyConnectedComponents returns the strongly connected components of the graph using Tarjan's algorithm, each
+// as a sorted list of names.
+func (g *Graph) stronglyConnectedComponents() [][]string {
+	index := map[string]int{}
+	lowLink := map[string]int{}
+	onStack := map[string]bool{}
+	var stack []string
+	var sccs [][]string
+
+	var visit func(name string)
+	visit = func(name string) {
+		index[name] = len(index)
+		lowLink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, nudged := range g.nudges[name] {
+			if _, visited := index[nudged]; !visited {
+				visit(nudged)
+				if lowLink[nudged] < lowLink[name] {
+					lowLink[name] = lowLink[nudged]
+				}
+			} else if onStack[nudged] && index[nudged] < lowLink[name] {
+				lowLink[name] = index[nudged]
+			}
+		}
+
+		if lowLink[name] == index[name] {
+			var scc []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				scc = append(scc, top)
+				if top == name {
+					break
+				}
+			}
+			sort.Strings(scc)
+			sccs = append(sccs, scc)
+		}
+	}
+
+	for _, name := range g.names {
+		if _, visited := index[name]; !visited {
+			visit(name)
+		}
+	}
+	return sccs
+}
+
+func (g *Graph) nudgesItself(name string) bool {
+	for _, nudged := range g.nudges[name] {
+		if nudged == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Graph) cycleError() error {
+	var errs []error
+	for _, err := range g.Cycles() {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// validReferences returns the deduplicated nudge references of a Component to existing Components of the same
+// Application.
+func (g *Graph) validReferences(name string) []string {
+	component := g.components[name]
+	seen := map[string]bool{}
+	var refs []string
+	for _, nudged := range component.Spec.BuildNudgesRef {
+		nudgedComponent, exists := g.components[nudged]
+		if !exists || seen[nudged] || nudgedComponent.Spec.Application != component.Spec.Application {
+			continue
+		}
+		seen[nudged] = true
+		refs = append(refs, nudged)
+	}
+	return refs
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promotionrun
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequiresApproval returns true if promotions to the given Environment require an approval.
+func RequiresApproval(env *appstudiov1alpha1.Environment) bool {
+	return env.Spec.ApprovalPolicy != nil
+}
+
+// RequiredApprovals returns the number of approvals required by the given approval policy.
+func RequiredApprovals(policy *appstudiov1alpha1.ApprovalPolicy) int {
+	if policy.RequiredApprovals < 1 {
+		return 1
+	}
+	return policy.RequiredApprovals
+}
+
+// IsEligibleApprover returns true if the given user, member of the given groups, may approve a promotion under the
+// given approval policy.
+func IsEligibleApprover(policy *appstudiov1alpha1.ApprovalPolicy, user string, groups []string) bool {
+	if len(policy.Approvers) == 0 && len(policy.ApproverGroups) == 0 {
+		return true
+	}
+	for _, approver := range policy.Approvers {
+		if approver == user {
+			return true
+		}
+	}
+	for _, approverGroup := range policy.ApproverGroups {
+		for _, group := range groups {
+			if approverGroup == group {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetApproval returns the approval of the given environment, or nil if the PromotionRun has not requested one.
+func GetApproval(promotionRun *appstudiov1alpha1.PromotionRun, environmentName string) *appstudiov1alpha1.PromotionRunEnvironmentApproval {
+	for i := range promotionRun.Status.Approvals {
+		if promotionRun.Status.Approvals[i].EnvironmentName == environmentName {
+			return &promotionRun.Status.Approvals[i]
+		}
+	}
+	return nil
+}
+
+// IsApproved returns true if the promotion to the given environment may proceed: either the environment does not
+// require an approval, or its approval has been granted.
+func IsApproved(promotionRun *appstudiov1alpha1.PromotionRun, env *appstudiov1alpha1.Environment) bool {
+	if !RequiresApproval(env) {
+		return true
+	}
+	approval := GetApproval(promotionRun, env.Name)
+	return approval != nil && approval.State == appstudiov1alpha1.ApprovalState_Approved
+}
+
+// RequestApproval records a pending approval for the given Environment, and moves the PromotionRun to the Waiting
+// state. Requesting an approval which was already requested has no effect on the approval.
+func (m *StateMachine) RequestApproval(env *appstudiov1alpha1.Environment) error {
+	if !RequiresApproval(env) {
+		return fmt.Errorf("environment %q does not require an approval", env.Name)
+	}
+	if GetApproval(m.promotionRun, env.Name) != nil {
+		return nil
+	}
+	if err := m.Wait(); err != nil {
+		return err
+	}
+
+	m.promotionRun.Status.Approvals = append(m.promotionRun.Status.Approvals, appstudiov1alpha1.PromotionRunEnvironmentApproval{
+		EnvironmentName:   env.Name,
+		State:             appstudiov1alpha1.ApprovalState_Pending,
+		RequiredApprovals: RequiredApprovals(env.Spec.ApprovalPolicy),
+	})
+	return nil
+}
+
+// RecordDecision records the decision of an approver on the pending approval of the given Environment, and returns
+// the resulting state of the approval. The approval is granted once the required number of distinct approvers have
+// approved, and rejected as soon as one approver rejects.
+//
+// The decision is recorded with now as its time. An error is returned if the decision is neither Approved nor Rejected,
+// if no approval is pending for the Environment, if the approver is not eligible under the approval policy of the
+// Environment, or if the approver has already decided.
+func (m *StateMachine) RecordDecision(now metav1.Time, env *appstudiov1alpha1.Environment, approver string, groups []string,
+	decision appstudiov1alpha1.ApprovalDecisionType, comment string) (appstudiov1alpha1.ApprovalState, error) {
+
+	if decision != appstudiov1alpha1.ApprovalDecision_Approved && decision != appstudiov1alpha1.ApprovalDecision_Rejected {
+		return "", fmt.Errorf(appstudiov1alpha1.InvalidApprovalDecision, decision)
+	}
+
+	approval := GetApproval(m.promotionRun, env.Name)
+	if approval == nil || approval.State != appstudiov1alpha1.ApprovalState_Pending {
+		return "", fmt.Errorf("no approval is pending for environment %q", env.Name)
+	}
+	if !RequiresApproval(env) || !IsEligibleApprover(env.Spec.ApprovalPolicy, approver, groups) {
+		return "", fmt.Errorf("user %q may not approve promotions to environment %q", approver, env.Name)
+	}
+	for _, existing := range approval.Decisions {
+		if existing.Approver == approver {
+			return "", fmt.Errorf("user %q has already decided on the promotion to environment %q", approver, env.Name)
+		}
+	}
+
+	approval.Decisions = append(approval.Decisions, appstudiov1alpha1.ApprovalDecision{
+		Approver: approver,
+		Decision: decision,
+		Comment:  comment,
+		Time:     now,
+	})
+
+	approved := 0
+	for _, existing := range approval.Decisions {
+		switch existing.Decision {
+		case appstudiov1alpha1.ApprovalDecision_Rejected:
+			approval.State = appstudiov1alpha1.ApprovalState_Rejected
+			return approval.State, nil
+		case appstudiov1alpha1.ApprovalDecision_Approved:
+			approved++
+		}
+	}
+	if approved >= approval.RequiredApprovals {
+		approval.State = appstudiov1alpha1.ApprovalState_Approved
+	}
+	return approval.State, nil
+}
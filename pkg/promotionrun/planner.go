@@ -0,0 +1,294 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promotionrun
+
+import (
+	"fmt"
+	"sort"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/environmentgraph"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BindingAction describes what a promotion does to the SnapshotEnvironmentBinding of an Environment.
+type BindingAction string
+
+const (
+	// BindingAction_Create is used when the Environment has no SnapshotEnvironmentBinding for the Application yet
+	BindingAction_Create BindingAction = "Create"
+	// BindingAction_Update is used when the SnapshotEnvironmentBinding of the Environment references another Snapshot
+	BindingAction_Update BindingAction = "Update"
+	// BindingAction_None is used when the SnapshotEnvironmentBinding of the Environment already references the Snapshot
+	BindingAction_None BindingAction = "None"
+)
+
+// PlannedBinding describes the promotion of the Snapshot to a single Environment.
+type PlannedBinding struct {
+	// EnvironmentName is the name of the Environment promoted to
+	EnvironmentName string
+
+	// Action describes what the promotion does to the SnapshotEnvironmentBinding of the Environment
+	Action BindingAction
+
+	// Binding is the desired SnapshotEnvironmentBinding: a new binding when Action is Create, and an updated copy of
+	// the existing binding otherwise. A new binding has a generated name, and its components are those of the Snapshot
+	// when it is known.
+	Binding *appstudiov1alpha1.SnapshotEnvironmentBinding
+}
+
+// Plan is the predicted course of a PromotionRun.
+type Plan struct {
+	// Waves are the bindings to create or update, in order: the bindings of a wave are promoted to together, once the
+	// promotions of the previous wave have succeeded. A manual promotion has a single wave.
+	Waves [][]PlannedBinding
+
+	// Skipped are the sorted names of the descendants of the initial Environment of an automated promotion which are not
+	// promoted to, since they (or one of their ancestors) do not use the AppStudioAutomated deployment strategy
+	Skipped []string
+
+	// EnvironmentStatus are the predicted environment status steps of the PromotionRun
+	EnvironmentStatus []appstudiov1alpha1.PromotionRunEnvironmentStatus
+
+	// ApprovalsRequired are the names of the Environments promoted to which require an approval, in promotion order
+	ApprovalsRequired []string
+
+	// Rollback is the predicted rollback status of the PromotionRun. Only set for a rollback.
+	Rollback *appstudiov1alpha1.PromotionRunRollbackStatus
+}
+
+// PlanInput contains the resources a promotion is planned from.
+type PlanInput struct {
+	// PromotionRun is the PromotionRun to plan
+	PromotionRun *appstudiov1alpha1.PromotionRun
+
+	// Environments are the Environments of the namespace. A nil list is treated as an empty one.
+	Environments *appstudiov1alpha1.EnvironmentList
+
+	// Bindings are the existing SnapshotEnvironmentBindings of the namespace
+	Bindings *appstudiov1alpha1.SnapshotEnvironmentBindingList
+
+	// Snapshot is the Snapshot being promoted. Optional: when set, the components of the created and updated bindings
+	// are those of the Snapshot.
+	Snapshot *appstudiov1alpha1.Snapshot
+
+	// PreviousSnapshot is the name of the Snapshot that was deployed to the target Environment of a rollback before
+	// the current one, see deploymenthistory.PreviousSnapshot. Only required to plan a rollback to the previous Snapshot.
+	PreviousSnapshot string
+}
+
+// NewPlan predicts the course of a PromotionRun, without modifying any resource, so the plan can be presented before
+// the promotion is started.
+//
+// A manual promotion promotes to its target Environment only. An automated promotion promotes to its initial
+// Environment, then to its children which use the AppStudioAutomated deployment strategy, and so on, one depth of the
+// Environment graph at a time. A rollback reverts the existing binding of its target Environment to the Snapshot
+// to roll back to.
+func NewPlan(input PlanInput) (*Plan, error) {
+	spec := input.PromotionRun.Spec
+	promotionType, err := spec.PromotionType()
+	if err != nil {
+		return nil, err
+	}
+
+	environments := input.Environments
+	if environments == nil {
+		environments = &appstudiov1alpha1.EnvironmentList{}
+	}
+	graph := environmentgraph.New(environments)
+	plan := &Plan{}
+	snapshot := spec.Snapshot
+
+	var levels [][]string
+	switch promotionType {
+	case appstudiov1alpha1.ManualPromotionType:
+		if graph.Environment(spec.ManualPromotion.TargetEnvironment) == nil {
+			return nil, fmt.Errorf("target environment %q does not exist", spec.ManualPromotion.TargetEnvironment)
+		}
+		levels = [][]string{{spec.ManualPromotion.TargetEnvironment}}
+	case appstudiov1alpha1.AutomatedPromotionType:
+		levels, plan.Skipped, err = automatedLevels(graph, spec.AutomatedPromotion.InitialEnvironment)
+		if err != nil {
+			return nil, err
+		}
+	case appstudiov1alpha1.RollbackPromotionType:
+		if graph.Environment(spec.Rollback.TargetEnvironment) == nil {
+			return nil, fmt.Errorf("target environment %q does not exist", spec.Rollback.TargetEnvironment)
+		}
+		plan.Rollback, err = planRollback(input)
+		if err != nil {
+			return nil, err
+		}
+		snapshot = plan.Rollback.Snapshot
+		levels = [][]string{{spec.Rollback.TargetEnvironment}}
+	}
+
+	for _, level := range levels {
+		var wave []PlannedBinding
+		for _, envName := range level {
+			planned := planBinding(input, envName, snapshot)
+			wave = append(wave, planned)
+
+			step := appstudiov1alpha1.PromotionRunEnvironmentStatus{
+				Step:            len(plan.EnvironmentStatus) + 1,
+				EnvironmentName: envName,
+				Status:          appstudiov1alpha1.PromotionRunEnvironmentStatus_InProgress,
+			}
+			if planned.Action == BindingAction_None {
+				step.Status = appstudiov1alpha1.PromotionRunEnvironmentStatus_Success
+			} else if RequiresApproval(graph.Environment(envName)) {
+				plan.ApprovalsRequired = append(plan.ApprovalsRequired, envName)
+				step.Status = appstudiov1alpha1.PromotionRunEnvironmentStatus_WaitingForApproval
+			}
+			plan.EnvironmentStatus = append(plan.EnvironmentStatus, step)
+		}
+		plan.Waves = append(plan.Waves, wave)
+	}
+
+	return plan, nil
+}
+
+// automatedLevels returns the Environments promoted to by an automated promotion starting at the given Environment,
+// grouped by depth, along with the sorted names of the descendants which are skipped.
+func automatedLevels(graph *environmentgraph.Graph, initialEnvironment string) ([][]string, []string, error) {
+	allLevels, err := graph.LevelsFrom(initialEnvironment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	promoted := map[string]bool{initialEnvironment: true}
+	levels := [][]string{{initialEnvironment}}
+	var skipped []string
+	for _, level := range allLevels[1:] {
+		var next []string
+		for _, envName := range level {
+			if promoted[graph.Parent(envName)] &&
+				graph.Environment(envName).Spec.DeploymentStrategy == appstudiov1alpha1.DeploymentStrategy_AppStudioAutomated {
+				promoted[envName] = true
+				next = append(next, envName)
+			} else {
+				skipped = append(skipped, envName)
+			}
+		}
+		if len(next) > 0 {
+			levels = append(levels, next)
+		}
+	}
+	sort.Strings(skipped)
+
+	return levels, skipped, nil
+}
+
+// planRollback resolves the Snapshots involved in a rollback.
+func planRollback(input PlanInput) (*appstudiov1alpha1.PromotionRunRollbackStatus, error) {
+	spec := input.PromotionRun.Spec
+	envName := spec.Rollback.TargetEnvironment
+
+	existing := findBinding(input.Bindings, spec.Application, envName)
+	if existing == nil {
+		return nil, fmt.Errorf("environment %q has no binding of application %q to roll back", envName, spec.Application)
+	}
+
+	snapshot := spec.Rollback.ToSnapshot
+	if snapshot == "" || snapshot == appstudiov1alpha1.RollbackToPreviousSnapshot {
+		if input.PreviousSnapshot == "" {
+			return nil, fmt.Errorf("the snapshot previously deployed to environment %q is unknown", envName)
+		}
+		snapshot = input.PreviousSnapshot
+	}
+
+	return &appstudiov1alpha1.PromotionRunRollbackStatus{
+		Snapshot:         snapshot,
+		ReplacedSnapshot: existing.Spec.Snapshot,
+	}, nil
+}
+
+// planBinding plans the deployment of the given Snapshot to a single Environment.
+func planBinding(input PlanInput, envName, snapshot string) PlannedBinding {
+	promotionRun := input.PromotionRun
+	planned := PlannedBinding{EnvironmentName: envName}
+
+	if existing := findBinding(input.Bindings, promotionRun.Spec.Application, envName); existing != nil {
+		planned.Binding = existing.DeepCopy()
+		if existing.Spec.Snapshot == snapshot {
+			planned.Action = BindingAction_None
+		} else {
+			planned.Action = BindingAction_Update
+			planned.Binding.Spec.Snapshot = snapshot
+			if input.Snapshot != nil {
+				planned.Binding.Spec.Components = snapshotComponents(input.Snapshot, existing.Spec.Components)
+			}
+		}
+		return planned
+	}
+
+	planned.Action = BindingAction_Create
+	planned.Binding = &appstudiov1alpha1.SnapshotEnvironmentBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: promotionRun.Spec.Application + "-" + envName + "-",
+			Namespace:    promotionRun.Namespace,
+		},
+		Spec: appstudiov1alpha1.SnapshotEnvironmentBindingSpec{
+			Application: promotionRun.Spec.Application,
+			Environment: envName,
+			Snapshot:    snapshot,
+			Components:  []appstudiov1alpha1.BindingComponent{},
+		},
+	}
+	if input.Snapshot != nil {
+		planned.Binding.Spec.Components = snapshotComponents(input.Snapshot, nil)
+	}
+	return planned
+}
+
+// snapshotComponents returns the binding components of the components of the given Snapshot, in Snapshot order. The
+// configuration of a component is taken from the existing binding components of the same name, if any.
+func snapshotComponents(snapshot *appstudiov1alpha1.Snapshot, existing []appstudiov1alpha1.BindingComponent) []appstudiov1alpha1.BindingComponent {
+	existingComponents := map[string]appstudiov1alpha1.BindingComponent{}
+	for _, component := range existing {
+		existingComponents[component.Name] = component
+	}
+
+	components := []appstudiov1alpha1.BindingComponent{}
+	for _, snapshotComponent := range snapshot.Spec.Components {
+		component, exists := existingComponents[snapshotComponent.Name]
+		if !exists {
+			component = appstudiov1alpha1.BindingComponent{Name: snapshotComponent.Name}
+		}
+		components = append(components, *component.DeepCopy())
+	}
+	return components
+}
+
+// findBinding returns the SnapshotEnvironmentBinding of the given Application and Environment with the lowest name,
+// or nil if there is none.
+func findBinding(bindings *appstudiov1alpha1.SnapshotEnvironmentBindingList, application, environment string) *appstudiov1alpha1.SnapshotEnvironmentBinding {
+	if bindings == nil {
+		return nil
+	}
+	var found *appstudiov1alpha1.SnapshotEnvironmentBinding
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if binding.Spec.Application != application || binding.Spec.Environment != environment {
+			continue
+		}
+		if found == nil || binding.Name < found.Name {
+			found = binding
+		}
+	}
+	return found
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package promotionrun implements the state machine of a PromotionRun's status.
+//
+// A PromotionRun moves between the following states:
+//   - (new) -> Active or Waiting
+//   - Active <-> Waiting
+//   - (new), Active or Waiting -> Complete
+//
+// A new PromotionRun may complete without ever being started, for example when it is cancelled before it is first
+// reconciled, or when it is found to be invalid.
+//
+// Complete is terminal: once a PromotionRun is Complete, its status can no longer change. The CompletionResult is set
+// when (and only when) the PromotionRun is Complete. Each environment promoted to is recorded as a step in the
+// EnvironmentStatus list, with sequential step numbers starting from 1; a step can no longer change once it has
+// completed (either successfully or not).
+//
+// A PromotionRun may also complete before all environments are promoted to, when it is cancelled or times out; see
+// timeouts.go for the evaluation of its timeouts and retries.
+//
+// The package also plans the course of a PromotionRun over the Environment graph, see NewPlan.
+package promotionrun
+
+import (
+	"errors"
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// validTransitions maps each state to the states it may transition to, other than itself.
+var validTransitions = map[appstudiov1alpha1.PromotionRunState][]appstudiov1alpha1.PromotionRunState{
+	"": {
+		appstudiov1alpha1.PromotionRunState_Active,
+		appstudiov1alpha1.PromotionRunState_Waiting,
+		appstudiov1alpha1.PromotionRunState_Complete,
+	},
+	appstudiov1alpha1.PromotionRunState_Active: {
+		appstudiov1alpha1.PromotionRunState_Waiting,
+		appstudiov1alpha1.PromotionRunState_Complete,
+	},
+	appstudiov1alpha1.PromotionRunState_Waiting: {
+		appstudiov1alpha1.PromotionRunState_Active,
+		appstudiov1alpha1.PromotionRunState_Complete,
+	},
+	appstudiov1alpha1.PromotionRunState_Complete: {},
+}
+
+// ValidateTransition returns an error if a PromotionRun cannot transition between the given states.
+// Remaining in the same state is always valid.
+func ValidateTransition(from, to appstudiov1alpha1.PromotionRunState) error {
+	if from == to {
+		return nil
+	}
+	for _, valid := range validTransitions[from] {
+		if valid == to {
+			return nil
+		}
+	}
+	return fmt.Errorf(appstudiov1alpha1.InvalidPromotionRunStateTransition, from, to)
+}
+
+// IsStepCompleted returns true if the given environment status step has completed, either successfully or not.
+func IsStepCompleted(step appstudiov1alpha1.PromotionRunEnvironmentStatus) bool {
+	return step.Status == appstudiov1alpha1.PromotionRunEnvironmentStatus_Success ||
+		step.Status == appstudiov1alpha1.PromotionRunEnvironmentStatus_Failed
+}
+
+// StateMachine applies validated updates to the status of a PromotionRun.
+// The PromotionRun is updated in place, and is left unchanged if an update is rejected.
+type StateMachine struct {
+	promotionRun *appstudiov1alpha1.PromotionRun
+}
+
+// New returns a StateMachine for the given PromotionRun.
+func New(promotionRun *appstudiov1alpha1.PromotionRun) *StateMachine {
+	return &StateMachine{promotionRun: promotionRun}
+}
+
+// State returns the current state of the PromotionRun.
+func (m *StateMachine) State() appstudiov1alpha1.PromotionRunState {
+	return m.promotionRun.Status.State
+}
+
+// Start moves the PromotionRun to the Active state, and records the promotion start time if it is not set yet.
+// An error is returned if the PromotionRun is Complete.
+func (m *StateMachine) Start(now metav1.Time) error {
+	if err := m.transition(appstudiov1alpha1.PromotionRunState_Active); err != nil {
+		return err
+	}
+	if m.promotionRun.Status.PromotionStartTime.IsZero() {
+		m.promotionRun.Status.PromotionStartTime = now
+	}
+	return nil
+}
+
+// Wait moves the PromotionRun to the Waiting state. An error is returned if the PromotionRun is Complete.
+func (m *StateMachine) Wait() error {
+	return m.transition(appstudiov1alpha1.PromotionRunState_Waiting)
+}
+
+// completedReasons maps each completion result to the reason of the Completed condition.
+var completedReasons = map[appstudiov1alpha1.PromotionRunCompleteResult]appstudiov1alpha1.PromotionRunReasonType{
+	appstudiov1alpha1.PromotionRunCompleteResult_Success:   appstudiov1alpha1.PromotionRunReasonSucceeded,
+	appstudiov1alpha1.PromotionRunCompleteResult_Failure:   appstudiov1alpha1.PromotionRunReasonFailed,
+	appstudiov1alpha1.PromotionRunCompleteResult_TimedOut:  appstudiov1alpha1.PromotionRunReasonTimedOut,
+	appstudiov1alpha1.PromotionRunCompleteResult_Cancelled: appstudiov1alpha1.PromotionRunReasonCancelled,
+}
+
+// Complete moves the PromotionRun to the Complete state with the given result, clears its active bindings, and sets
+// its Completed condition with a reason matching the result and the given message. An error is returned if the
+// PromotionRun is already Complete, so that its result cannot be overwritten.
+func (m *StateMachine) Complete(result appstudiov1alpha1.PromotionRunCompleteResult, message string) error {
+	reason, valid := completedReasons[result]
+	if !valid {
+		return errors.New(appstudiov1alpha1.MissingPromotionRunCompletionResult)
+	}
+	if err := m.transition(appstudiov1alpha1.PromotionRunState_Complete); err != nil {
+		return err
+	}
+	m.promotionRun.Status.CompletionResult = result
+	m.promotionRun.Status.ActiveBindings = nil
+	conditions.SetPromotionRunConditionForObject(m.promotionRun, appstudiov1alpha1.PromotionRunCondition{
+		Type:    appstudiov1alpha1.PromotionRunConditionCompleted,
+		Status:  appstudiov1alpha1.PromotionRunConditionStatusTrue,
+		Reason:  reason,
+		Message: message,
+	})
+	return nil
+}
+
+// Cancel completes the PromotionRun with the Cancelled result, failing the steps which have not completed.
+// An error is returned if the PromotionRun is already Complete.
+func (m *StateMachine) Cancel() error {
+	return m.abort(appstudiov1alpha1.PromotionRunCompleteResult_Cancelled, "the promotion was cancelled")
+}
+
+// TimeOut completes the PromotionRun with the TimedOut result, failing the steps which have not completed.
+// An error is returned if the PromotionRun is already Complete.
+func (m *StateMachine) TimeOut() error {
+	return m.abort(appstudiov1alpha1.PromotionRunCompleteResult_TimedOut, "the promotion exceeded its timeout")
+}
+
+func (m *StateMachine) abort(result appstudiov1alpha1.PromotionRunCompleteResult, message string) error {
+	if m.State() == appstudiov1alpha1.PromotionRunState_Complete {
+		return errors.New(appstudiov1alpha1.PromotionRunCompleteError)
+	}
+	if err := ValidateTransition(m.State(), appstudiov1alpha1.PromotionRunState_Complete); err != nil {
+		return err
+	}
+	for i := range m.promotionRun.Status.EnvironmentStatus {
+		step := &m.promotionRun.Status.EnvironmentStatus[i]
+		if !IsStepCompleted(*step) {
+			step.Status = appstudiov1alpha1.PromotionRunEnvironmentStatus_Failed
+			step.DisplayStatus = message
+		}
+	}
+	return m.Complete(result, message)
+}
+
+// AddStep appends a new environment status step for the given environment, with the next sequential step number and
+// now as its start time.
+// An error is returned if the PromotionRun is Complete, or if the environment already has a step that has not completed.
+func (m *StateMachine) AddStep(now metav1.Time, environmentName string, status appstudiov1alpha1.PromotionRunEnvironmentStatusField,
+	displayStatus string) (*appstudiov1alpha1.PromotionRunEnvironmentStatus, error) {
+
+	if m.State() == appstudiov1alpha1.PromotionRunState_Complete {
+		return nil, fmt.Errorf("cannot add a step for environment %q: the promotion run is complete", environmentName)
+	}
+	if step := m.lastStep(environmentName); step != nil && !IsStepCompleted(*step) {
+		return nil, fmt.Errorf("cannot add a step for environment %q: step %d has not completed", environmentName, step.Step)
+	}
+
+	startTime := now
+	steps := &m.promotionRun.Status.EnvironmentStatus
+	*steps = append(*steps, appstudiov1alpha1.PromotionRunEnvironmentStatus{
+		Step:            len(*steps) + 1,
+		EnvironmentName: environmentName,
+		Status:          status,
+		DisplayStatus:   displayStatus,
+		StartTime:       &startTime,
+	})
+	return &(*steps)[len(*steps)-1], nil
+}
+
+// UpdateStep updates the most recent environment status step of the given environment.
+// An error is returned if the PromotionRun is Complete, if the environment has no step, or if its step has completed.
+func (m *StateMachine) UpdateStep(environmentName string, status appstudiov1alpha1.PromotionRunEnvironmentStatusField,
+	displayStatus string) error {
+
+	if m.State() == appstudiov1alpha1.PromotionRunState_Complete {
+		return fmt.Errorf("cannot update the step for environment %q: the promotion run is complete", environmentName)
+	}
+	step := m.lastStep(environmentName)
+	if step == nil {
+		return fmt.Errorf("cannot update the step for environment %q: no step exists", environmentName)
+	}
+	if IsStepCompleted(*step) {
+		return fmt.Errorf(appstudiov1alpha1.PromotionRunStepUpdateError, step.Step, environmentName)
+	}
+
+	step.Status = status
+	step.DisplayStatus = displayStatus
+	return nil
+}
+
+// RecordRollback records the Snapshots involved in a rollback: the Snapshot rolled back to, and the Snapshot it
+// replaces. An error is returned if the PromotionRun is Complete, or if different Snapshots were already recorded.
+func (m *StateMachine) RecordRollback(snapshot, replacedSnapshot string) error {
+	rollback := &appstudiov1alpha1.PromotionRunRollbackStatus{Snapshot: snapshot, ReplacedSnapshot: replacedSnapshot}
+
+	if existing := m.promotionRun.Status.Rollback; existing != nil {
+		if *existing != *rollback {
+			return errors.New(appstudiov1alpha1.RollbackStatusUpdateError)
+		}
+		return nil
+	}
+	if m.State() == appstudiov1alpha1.PromotionRunState_Complete {
+		return errors.New("cannot record the rollback: the promotion run is complete")
+	}
+
+	m.promotionRun.Status.Rollback = rollback
+	return nil
+}
+
+func (m *StateMachine) transition(to appstudiov1alpha1.PromotionRunState) error {
+	if m.State() == appstudiov1alpha1.PromotionRunState_Complete {
+		return errors.New(appstudiov1alpha1.PromotionRunCompleteError)
+	}
+	if err := ValidateTransition(m.State(), to); err != nil {
+		return err
+	}
+	m.promotionRun.Status.State = to
+	return nil
+}
+
+// lastStep returns the most recent step of the given environment, or nil if there is none.
+func (m *StateMachine) lastStep(environmentName string) *appstudiov1alpha1.PromotionRunEnvironmentStatus {
+	steps := m.promotionRun.Status.EnvironmentStatus
+	for i := len(steps) - 1; i >= 0; i-- {
+		if steps[i].EnvironmentName == environmentName {
+			return &steps[i]
+		}
+	}
+	return nil
+}
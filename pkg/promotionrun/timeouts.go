@@ -0,0 +1,100 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promotionrun
+
+import (
+	"time"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// Deadline returns the time at which the whole PromotionRun times out, and false if it has no timeout or has not
+// started yet.
+func Deadline(promotionRun *appstudiov1alpha1.PromotionRun) (time.Time, bool) {
+	if promotionRun.Spec.Timeout == nil || promotionRun.Status.PromotionStartTime.IsZero() {
+		return time.Time{}, false
+	}
+	return promotionRun.Status.PromotionStartTime.Add(promotionRun.Spec.Timeout.Duration), true
+}
+
+// StepDeadline returns the time at which the given environment status step times out, and false if the PromotionRun
+// has no environment timeout or the step has no start time.
+func StepDeadline(promotionRun *appstudiov1alpha1.PromotionRun, step appstudiov1alpha1.PromotionRunEnvironmentStatus) (time.Time, bool) {
+	if promotionRun.Spec.EnvironmentTimeout == nil || step.StartTime == nil {
+		return time.Time{}, false
+	}
+	return step.StartTime.Add(promotionRun.Spec.EnvironmentTimeout.Duration), true
+}
+
+// IsTimedOut returns true if the whole PromotionRun has exceeded its timeout at the given time. A completed
+// PromotionRun never times out.
+func IsTimedOut(promotionRun *appstudiov1alpha1.PromotionRun, now time.Time) bool {
+	if promotionRun.Status.State == appstudiov1alpha1.PromotionRunState_Complete {
+		return false
+	}
+	deadline, found := Deadline(promotionRun)
+	return found && !now.Before(deadline)
+}
+
+// TimedOutSteps returns the names of the environments whose step has not completed and has exceeded the environment
+// timeout at the given time.
+func TimedOutSteps(promotionRun *appstudiov1alpha1.PromotionRun, now time.Time) []string {
+	var envNames []string
+	for _, step := range promotionRun.Status.EnvironmentStatus {
+		if IsStepCompleted(step) {
+			continue
+		}
+		if deadline, found := StepDeadline(promotionRun, step); found && !now.Before(deadline) {
+			envNames = append(envNames, step.EnvironmentName)
+		}
+	}
+	return envNames
+}
+
+// RetriesRemaining returns how many more times the promotion to the given environment may be retried, based on the
+// retry policy of the PromotionRun and the number of failed steps of the environment.
+func RetriesRemaining(promotionRun *appstudiov1alpha1.PromotionRun, environmentName string) int {
+	if promotionRun.Spec.RetryPolicy == nil {
+		return 0
+	}
+	failures := 0
+	for _, step := range promotionRun.Status.EnvironmentStatus {
+		if step.EnvironmentName == environmentName && step.Status == appstudiov1alpha1.PromotionRunEnvironmentStatus_Failed {
+			failures++
+		}
+	}
+	// the first failure is not a retry
+	if failures == 0 {
+		return promotionRun.Spec.RetryPolicy.MaxRetries
+	}
+	if remaining := promotionRun.Spec.RetryPolicy.MaxRetries - (failures - 1); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// NextRetryTime returns the earliest time at which the failed promotion to the given environment may be retried, given
+// the time its last step failed, and false if no retry remains.
+func NextRetryTime(promotionRun *appstudiov1alpha1.PromotionRun, environmentName string, failedAt time.Time) (time.Time, bool) {
+	if RetriesRemaining(promotionRun, environmentName) == 0 {
+		return time.Time{}, false
+	}
+	if backoff := promotionRun.Spec.RetryPolicy.Backoff; backoff != nil {
+		return failedAt.Add(backoff.Duration), true
+	}
+	return failedAt, true
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package promotionrun
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateStatus validates the given PromotionRun status on its own: the completion result must be set if and only if
+// the state is Complete, and the environment status steps must be numbered sequentially starting from 1.
+func ValidateStatus(status *appstudiov1alpha1.PromotionRunStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if status.State == appstudiov1alpha1.PromotionRunState_Complete && status.CompletionResult == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("completionResult"), appstudiov1alpha1.MissingPromotionRunCompletionResult))
+	} else if status.State != appstudiov1alpha1.PromotionRunState_Complete && status.CompletionResult != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("completionResult"), appstudiov1alpha1.UnexpectedPromotionRunCompletionResult))
+	}
+
+	for i, step := range status.EnvironmentStatus {
+		if step.Step != i+1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("environmentStatus").Index(i).Child("step"), step.Step,
+				fmt.Sprintf(appstudiov1alpha1.InvalidPromotionRunStep, i+1)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateStatusUpdate validates an update of a PromotionRun status: in addition to the rules of ValidateStatus, the
+// status of a Complete PromotionRun may not be changed at all. Otherwise, the state must follow a valid transition,
+// existing steps may not be removed, completed steps may not be changed, and the promotion start time and rollback
+// status may not be changed once they have been set. Approval decisions may only be appended.
+func ValidateStatusUpdate(newStatus, oldStatus *appstudiov1alpha1.PromotionRunStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := ValidateStatus(newStatus, fldPath)
+
+	if oldStatus.State == appstudiov1alpha1.PromotionRunState_Complete {
+		if !apiequality.Semantic.DeepEqual(newStatus, oldStatus) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, appstudiov1alpha1.PromotionRunCompleteError))
+		}
+		return allErrs
+	}
+
+	if err := ValidateTransition(oldStatus.State, newStatus.State); err != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("state"), err.Error()))
+	}
+
+	stepsPath := fldPath.Child("environmentStatus")
+	for i, oldStep := range oldStatus.EnvironmentStatus {
+		if i >= len(newStatus.EnvironmentStatus) {
+			allErrs = append(allErrs, field.Forbidden(stepsPath.Index(i),
+				fmt.Sprintf(appstudiov1alpha1.PromotionRunStepRemovedError, oldStep.Step, oldStep.EnvironmentName)))
+			continue
+		}
+		newStep := newStatus.EnvironmentStatus[i]
+		if newStep.EnvironmentName != oldStep.EnvironmentName || (IsStepCompleted(oldStep) && !apiequality.Semantic.DeepEqual(newStep, oldStep)) {
+			allErrs = append(allErrs, field.Forbidden(stepsPath.Index(i),
+				fmt.Sprintf(appstudiov1alpha1.PromotionRunStepUpdateError, oldStep.Step, oldStep.EnvironmentName)))
+		}
+	}
+
+	// approval decisions are append-only
+	newApprovals := map[string]appstudiov1alpha1.PromotionRunEnvironmentApproval{}
+	for _, approval := range newStatus.Approvals {
+		newApprovals[approval.EnvironmentName] = approval
+	}
+	for i, oldApproval := range oldStatus.Approvals {
+		newApproval := newApprovals[oldApproval.EnvironmentName]
+		if len(newApproval.Decisions) < len(oldApproval.Decisions) ||
+			!apiequality.Semantic.DeepEqual(newApproval.Decisions[:len(oldApproval.Decisions)], oldApproval.Decisions) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("approvals").Index(i).Child("decisions"),
+				fmt.Sprintf(appstudiov1alpha1.ApprovalDecisionUpdateError, oldApproval.EnvironmentName)))
+		}
+	}
+
+	if oldStatus.Rollback != nil && !apiequality.Semantic.DeepEqual(newStatus.Rollback, oldStatus.Rollback) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollback"), appstudiov1alpha1.RollbackStatusUpdateError))
+	}
+
+	if !oldStatus.PromotionStartTime.IsZero() && !newStatus.PromotionStartTime.Equal(&oldStatus.PromotionStartTime) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("promotionStartTime"), appstudiov1alpha1.PromotionStartTimeUpdateError))
+	}
+
+	return allErrs
+}
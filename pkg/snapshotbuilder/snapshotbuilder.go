@@ -0,0 +1,164 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshotbuilder assembles Snapshots from the current state of the Components of an Application.
+package snapshotbuilder
+
+import (
+	"fmt"
+	"sort"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentOverride replaces the image of a single Component within the built Snapshot, for example to build the
+// Snapshot of a "component push", where a new image of one Component is tested along with the current images of the
+// other Components.
+type ComponentOverride struct {
+	// ComponentName is the name of the Component whose image is replaced
+	ComponentName string
+
+	// ContainerImage is the container image to use for the Component
+	ContainerImage string
+
+	// Revision is the commit the container image was built from. Optional: if unset, the last built commit of the
+	// Component is kept.
+	Revision string
+}
+
+// Options configures the Snapshot built by New.
+type Options struct {
+	// Name is the name of the Snapshot. Optional: if unset, the Snapshot is created with a name generated from the
+	// name of the Application.
+	Name string
+
+	// Override replaces the image of a single Component. Optional.
+	Override *ComponentOverride
+}
+
+// Result is the outcome of building a Snapshot.
+type Result struct {
+	// Snapshot is the built Snapshot, which contains a component for each Component of the Application with an image
+	Snapshot *appstudiov1alpha1.Snapshot
+
+	// MissingImages are the sorted names of the Components of the Application which have no built image yet, and
+	// which are therefore not part of the Snapshot
+	MissingImages []string
+}
+
+// Complete returns true if every Component of the Application is part of the Snapshot.
+func (r *Result) Complete() bool {
+	return len(r.MissingImages) == 0
+}
+
+// New builds a Snapshot of the given Application, with one component per Component of componentList which belongs to
+// the Application. Components of other Applications are ignored.
+//
+// The image of each component is the image last built for the Component, or for Components without a build, the
+// image of the Component's image source or spec.containerImage. The source of each component is the source of the
+// Component, pinned to the last built commit when known, which is also recorded within the Snapshot's artifacts.
+//
+// An error is returned if the Override references a Component which does not belong to the Application.
+func New(application *appstudiov1alpha1.Application, componentList *appstudiov1alpha1.ComponentList, opts Options) (*Result, error) {
+	snapshot := &appstudiov1alpha1.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.Name,
+			Namespace: application.Namespace,
+		},
+		Spec: appstudiov1alpha1.SnapshotSpec{
+			Application: application.Name,
+		},
+	}
+	if opts.Name == "" {
+		snapshot.GenerateName = application.Name + "-"
+	}
+	result := &Result{Snapshot: snapshot}
+
+	var components []*appstudiov1alpha1.Component
+	for i := range componentList.Items {
+		if componentList.Items[i].Spec.Application == application.Name {
+			components = append(components, &componentList.Items[i])
+		}
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	overrideFound := false
+	for _, component := range components {
+		image := imageOf(component)
+		revision := component.Status.LastBuiltCommit
+		if opts.Override != nil && opts.Override.ComponentName == component.Name {
+			overrideFound = true
+			image = opts.Override.ContainerImage
+			if opts.Override.Revision != "" {
+				revision = opts.Override.Revision
+			}
+		}
+
+		if image == "" {
+			result.MissingImages = append(result.MissingImages, component.Name)
+			continue
+		}
+		addComponent(snapshot, component, image, revision)
+	}
+
+	if opts.Override != nil && !overrideFound {
+		return nil, fmt.Errorf("component %q does not belong to application %q", opts.Override.ComponentName, application.Name)
+	}
+
+	return result, nil
+}
+
+// imageOf returns the current image of a Component, or an empty string if it has none.
+func imageOf(component *appstudiov1alpha1.Component) string {
+	if component.Status.ContainerImage != "" {
+		return component.Status.ContainerImage
+	}
+	if imageSource := component.Spec.Source.ImageSource; imageSource != nil && imageSource.ContainerImage != "" {
+		if imageSource.Digest != "" {
+			return imageSource.ContainerImage + "@" + imageSource.Digest
+		}
+		return imageSource.ContainerImage
+	}
+	return component.Spec.ContainerImage
+}
+
+// addComponent adds a Component to the Snapshot with the given image, pinning its git source to the given revision.
+func addComponent(snapshot *appstudiov1alpha1.Snapshot, component *appstudiov1alpha1.Component, image, revision string) {
+	source := *component.Spec.Source.DeepCopy()
+	gitSource := source.GitSource
+	if gitSource != nil && revision != "" {
+		gitSource.Revision = revision
+	}
+
+	snapshot.Spec.Components = append(snapshot.Spec.Components, appstudiov1alpha1.SnapshotComponent{
+		Name:           component.Name,
+		ContainerImage: image,
+		Source:         source,
+	})
+
+	if gitSource != nil && gitSource.URL != "" && revision != "" {
+		snapshot.Spec.Artifacts.Components = append(snapshot.Spec.Artifacts.Components, appstudiov1alpha1.SnapshotComponentArtifacts{
+			Name: component.Name,
+			Source: &appstudiov1alpha1.SnapshotArtifactSource{
+				RepositoryURL: gitSource.URL,
+				CommitSHA:     revision,
+			},
+		})
+	}
+}
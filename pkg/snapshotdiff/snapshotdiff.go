@@ -0,0 +1,316 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package snapshotdiff compares the components of two Snapshots, for example the Snapshot deployed to an Environment
+// and the Snapshot about to be promoted to it, and renders the differences as text, JSON or Markdown.
+package snapshotdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+)
+
+// ChangeType describes how a component differs between two Snapshots.
+type ChangeType string
+
+const (
+	// ChangeType_Added is used for a component which only exists in the new Snapshot
+	ChangeType_Added ChangeType = "Added"
+	// ChangeType_Removed is used for a component which only exists in the old Snapshot
+	ChangeType_Removed ChangeType = "Removed"
+	// ChangeType_Modified is used for a component whose image or source revision differs between the Snapshots
+	ChangeType_Modified ChangeType = "Modified"
+)
+
+// Image identifies the container image of a component within a Snapshot.
+type Image struct {
+	// ContainerImage is the container image of the component
+	ContainerImage string `json:"containerImage"`
+
+	// Digest is the digest of the container image, when recorded in the Snapshot's artifacts
+	Digest string `json:"digest,omitempty"`
+}
+
+// SourceRevision identifies the source code revision of a component within a Snapshot.
+type SourceRevision struct {
+	// URL is the URL of the git repository
+	URL string `json:"url"`
+
+	// Revision is the commit the container image was built from when recorded in the Snapshot's artifacts, and the
+	// branch, tag or commit of the component's git source otherwise
+	Revision string `json:"revision,omitempty"`
+}
+
+// ComponentDiff describes the differences of a single component between two Snapshots.
+type ComponentDiff struct {
+	// Name is the name of the component
+	Name string `json:"name"`
+
+	// Change describes how the component differs between the Snapshots
+	Change ChangeType `json:"change"`
+
+	// OldImage is the image of the component in the old Snapshot, unset if the component was added
+	OldImage *Image `json:"oldImage,omitempty"`
+
+	// NewImage is the image of the component in the new Snapshot, unset if the component was removed
+	NewImage *Image `json:"newImage,omitempty"`
+
+	// OldSource is the source revision of the component in the old Snapshot, if known
+	OldSource *SourceRevision `json:"oldSource,omitempty"`
+
+	// NewSource is the source revision of the component in the new Snapshot, if known
+	NewSource *SourceRevision `json:"newSource,omitempty"`
+}
+
+// ImageChanged returns true if the image of the component differs between the Snapshots.
+func (c *ComponentDiff) ImageChanged() bool {
+	return c.Change == ChangeType_Modified && *c.OldImage != *c.NewImage
+}
+
+// SourceChanged returns true if the source revision of the component differs between the Snapshots.
+func (c *ComponentDiff) SourceChanged() bool {
+	return c.Change == ChangeType_Modified && !equalSources(c.OldSource, c.NewSource)
+}
+
+// Diff describes the differences between the components of two Snapshots.
+type Diff struct {
+	// OldSnapshot is the name of the old Snapshot, empty if there is none (for example on a first deployment)
+	OldSnapshot string `json:"oldSnapshot,omitempty"`
+
+	// NewSnapshot is the name of the new Snapshot
+	NewSnapshot string `json:"newSnapshot"`
+
+	// Components are the components which differ between the Snapshots, sorted by name
+	Components []ComponentDiff `json:"components,omitempty"`
+
+	// Unchanged are the sorted names of the components which are identical in both Snapshots
+	Unchanged []string `json:"unchanged,omitempty"`
+}
+
+// Compare returns the differences between the components of oldSnapshot and newSnapshot. oldSnapshot may be nil, in
+// which case all components of newSnapshot are reported as added.
+func Compare(oldSnapshot, newSnapshot *appstudiov1alpha1.Snapshot) *Diff {
+	diff := &Diff{NewSnapshot: newSnapshot.Name}
+
+	oldComponents := map[string]appstudiov1alpha1.SnapshotComponent{}
+	if oldSnapshot != nil {
+		diff.OldSnapshot = oldSnapshot.Name
+		for _, component := range oldSnapshot.Spec.Components {
+			oldComponents[component.Name] = component
+		}
+	}
+	newComponents := map[string]appstudiov1alpha1.SnapshotComponent{}
+	for _, component := range newSnapshot.Spec.Components {
+		newComponents[component.Name] = component
+	}
+
+	var names []string
+	for name := range oldComponents {
+		names = append(names, name)
+	}
+	for name := range newComponents {
+		if _, exists := oldComponents[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		oldComponent, inOld := oldComponents[name]
+		newComponent, inNew := newComponents[name]
+
+		componentDiff := ComponentDiff{Name: name}
+		if inOld {
+			componentDiff.OldImage = imageOf(oldSnapshot, oldComponent)
+			componentDiff.OldSource = sourceOf(oldSnapshot, oldComponent)
+		}
+		if inNew {
+			componentDiff.NewImage = imageOf(newSnapshot, newComponent)
+			componentDiff.NewSource = sourceOf(newSnapshot, newComponent)
+		}
+
+		switch {
+		case !inOld:
+			componentDiff.Change = ChangeType_Added
+		case !inNew:
+			componentDiff.Change = ChangeType_Removed
+		case *componentDiff.OldImage != *componentDiff.NewImage || !equalSources(componentDiff.OldSource, componentDiff.NewSource):
+			componentDiff.Change = ChangeType_Modified
+		default:
+			diff.Unchanged = append(diff.Unchanged, name)
+			continue
+		}
+		diff.Components = append(diff.Components, componentDiff)
+	}
+
+	return diff
+}
+
+// IsEmpty returns true if the components of both Snapshots are identical.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Components) == 0
+}
+
+// String renders the differences as plain text, one line per changed component.
+func (d *Diff) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Changes from snapshot %s to snapshot %s:\n", orNone(d.OldSnapshot), d.NewSnapshot)
+	if d.IsEmpty() {
+		sb.WriteString("  no changes\n")
+	}
+	for _, c := range d.Components {
+		switch c.Change {
+		case ChangeType_Added:
+			fmt.Fprintf(&sb, "  + %s: %s%s\n", c.Name, c.NewImage, sourceSuffix(c.NewSource))
+		case ChangeType_Removed:
+			fmt.Fprintf(&sb, "  - %s: %s%s\n", c.Name, c.OldImage, sourceSuffix(c.OldSource))
+		case ChangeType_Modified:
+			fmt.Fprintf(&sb, "  ~ %s:\n", c.Name)
+			if c.ImageChanged() {
+				fmt.Fprintf(&sb, "      image: %s -> %s\n", c.OldImage, c.NewImage)
+			}
+			if c.SourceChanged() {
+				fmt.Fprintf(&sb, "      source: %s -> %s\n", c.OldSource, c.NewSource)
+			}
+		}
+	}
+	if len(d.Unchanged) > 0 {
+		fmt.Fprintf(&sb, "Unchanged: %s\n", strings.Join(d.Unchanged, ", "))
+	}
+	return sb.String()
+}
+
+// JSON renders the differences as indented JSON.
+func (d *Diff) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+// Markdown renders the differences as a Markdown table, suitable for pull request descriptions and approval UIs.
+func (d *Diff) Markdown() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "### Changes from snapshot `%s` to snapshot `%s`\n\n", orNone(d.OldSnapshot), d.NewSnapshot)
+	if d.IsEmpty() {
+		sb.WriteString("No changes.\n")
+	} else {
+		sb.WriteString("| Component | Change | Image | Source |\n")
+		sb.WriteString("|---|---|---|---|\n")
+		for _, c := range d.Components {
+			var image, source string
+			switch c.Change {
+			case ChangeType_Added:
+				image, source = markdownCode(c.NewImage), markdownSource(c.NewSource)
+			case ChangeType_Removed:
+				image, source = markdownCode(c.OldImage), markdownSource(c.OldSource)
+			case ChangeType_Modified:
+				if c.ImageChanged() {
+					image = markdownCode(c.OldImage) + " → " + markdownCode(c.NewImage)
+				}
+				if c.SourceChanged() {
+					source = markdownCode(c.OldSource) + " → " + markdownCode(c.NewSource)
+				}
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", markdownCell(c.Name), c.Change, image, source)
+		}
+	}
+	if len(d.Unchanged) > 0 {
+		fmt.Fprintf(&sb, "\nUnchanged components: %s\n", strings.Join(d.Unchanged, ", "))
+	}
+	return sb.String()
+}
+
+func (i *Image) String() string {
+	if i.Digest == "" {
+		return i.ContainerImage
+	}
+	return fmt.Sprintf("%s (%s)", i.ContainerImage, i.Digest)
+}
+
+func (s *SourceRevision) String() string {
+	if s == nil {
+		return "(unknown)"
+	}
+	if s.Revision == "" {
+		return s.URL
+	}
+	return fmt.Sprintf("%s@%s", s.URL, s.Revision)
+}
+
+// imageOf returns the image of a component, including its digest if recorded in the Snapshot's artifacts.
+func imageOf(snapshot *appstudiov1alpha1.Snapshot, component appstudiov1alpha1.SnapshotComponent) *Image {
+	image := &Image{ContainerImage: component.ContainerImage}
+	if artifacts := snapshot.GetComponentArtifacts(component.Name); artifacts != nil {
+		image.Digest = artifacts.ImageDigest
+	}
+	return image
+}
+
+// sourceOf returns the source revision of a component, preferring the commit recorded in the Snapshot's artifacts over
+// the revision of the component's git source. Returns nil if the source revision is unknown.
+func sourceOf(snapshot *appstudiov1alpha1.Snapshot, component appstudiov1alpha1.SnapshotComponent) *SourceRevision {
+	if artifacts := snapshot.GetComponentArtifacts(component.Name); artifacts != nil && artifacts.Source != nil {
+		return &SourceRevision{URL: artifacts.Source.RepositoryURL, Revision: artifacts.Source.CommitSHA}
+	}
+	if gitSource := component.Source.GitSource; gitSource != nil && gitSource.URL != "" {
+		return &SourceRevision{URL: gitSource.URL, Revision: gitSource.Revision}
+	}
+	return nil
+}
+
+func equalSources(a, b *SourceRevision) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sourceSuffix(source *SourceRevision) string {
+	if source == nil {
+		return ""
+	}
+	return " from " + source.String()
+}
+
+func markdownCode(value fmt.Stringer) string {
+	return "`" + markdownCell(value.String()) + "`"
+}
+
+// markdownCellReplacer escapes the characters which would break a Markdown table row: pipes end the cell, and line
+// breaks end the row.
+var markdownCellReplacer = strings.NewReplacer("|", "\\|", "\r\n", " ", "\n", " ", "\r", " ")
+
+// markdownCell escapes a value rendered in a Markdown table cell.
+func markdownCell(value string) string {
+	return markdownCellReplacer.Replace(value)
+}
+
+// markdownSource renders a source revision of an added or removed component, which is left empty if unknown.
+func markdownSource(source *SourceRevision) string {
+	if source == nil {
+		return ""
+	}
+	return markdownCode(source)
+}
+
+func orNone(name string) string {
+	if name == "" {
+		return "(none)"
+	}
+	return name
+}
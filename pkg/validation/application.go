@@ -0,0 +1,60 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"reflect"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateApplicationCreate validates a new Application.
+func ValidateApplicationCreate(app *appstudiov1alpha1.Application) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("displayName"), app.Spec.DisplayName)...)
+	allErrs = append(allErrs, validateApplicationGitRepository(specPath.Child("appModelRepository"), app.Spec.AppModelRepository)...)
+	allErrs = append(allErrs, validateApplicationGitRepository(specPath.Child("gitOpsRepository"), app.Spec.GitOpsRepository)...)
+
+	return allErrs
+}
+
+// ValidateApplicationUpdate validates an update of an Application: the app model and GitOps repositories may not be changed.
+func ValidateApplicationUpdate(newApp, oldApp *appstudiov1alpha1.Application) field.ErrorList {
+	allErrs := ValidateApplicationCreate(newApp)
+
+	if !reflect.DeepEqual(newApp.Spec.AppModelRepository, oldApp.Spec.AppModelRepository) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("appModelRepository"),
+			fmt.Sprintf(appstudiov1alpha1.AppModelRepositoryUpdateError, newApp.Spec.AppModelRepository)))
+	}
+	if !reflect.DeepEqual(newApp.Spec.GitOpsRepository, oldApp.Spec.GitOpsRepository) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("gitOpsRepository"),
+			fmt.Sprintf(appstudiov1alpha1.GitOpsRepositoryUpdateError, newApp.Spec.GitOpsRepository)))
+	}
+
+	return allErrs
+}
+
+// validateApplicationGitRepository validates an (optional) app model or GitOps repository: a repository is generated if the URL is empty.
+func validateApplicationGitRepository(fldPath *field.Path, repo appstudiov1alpha1.ApplicationGitRepository) field.ErrorList {
+	if repo.URL == "" {
+		return nil
+	}
+	return validateURL(fldPath.Child("url"), repo.URL, appstudiov1alpha1.InvalidSchemeGitSourceURL, "https", "http")
+}
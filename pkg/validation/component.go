@@ -0,0 +1,164 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/nudgegraph"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	imageDigestFmt     = "sha256:[a-f0-9]{64}"
+	imageDigestExample = "sha256:1b2dd8b2d2d8b5e1ab8a3e7f0ee0c5b1dd3d31ac3b1b6c16b2d0c5ae1c1a8c4d"
+)
+
+var imageDigestRegexp = regexp.MustCompile("^" + imageDigestFmt + "$")
+
+// ValidateComponentCreate validates a new Component.
+func ValidateComponentCreate(comp *appstudiov1alpha1.Component) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// We use the DNS-1035 format for component names, so ensure it conforms to that specification
+	if len(validation.IsDNS1035Label(comp.Name)) != 0 {
+		allErrs = append(allErrs, field.Invalid(metadataPath.Child("name"), comp.Name, fmt.Sprintf(appstudiov1alpha1.InvalidDNS1035Name, comp.Name)))
+	}
+	allErrs = append(allErrs, validateDNS1123Label(specPath.Child("componentName"), comp.Spec.ComponentName)...)
+	allErrs = append(allErrs, validateDNS1123Label(specPath.Child("application"), comp.Spec.Application)...)
+
+	sourcePath := specPath.Child("source")
+	if comp.Spec.Source.GitSource != nil && comp.Spec.Source.ImageSource != nil {
+		allErrs = append(allErrs, field.Forbidden(sourcePath, appstudiov1alpha1.MultipleGitAndImageSource))
+	}
+
+	sourceSpecified := false
+	if comp.Spec.Source.GitSource != nil {
+		allErrs = append(allErrs, validateGitSource(sourcePath.Child("git"), comp.Spec.Source.GitSource)...)
+		sourceSpecified = true
+	}
+	if comp.Spec.Source.ImageSource != nil {
+		allErrs = append(allErrs, validateImageSource(sourcePath.Child("image"), comp.Spec.Source.ImageSource)...)
+		sourceSpecified = true
+	}
+	// A container image on the spec is still accepted in place of an image source, for backwards compatibility
+	if comp.Spec.ContainerImage != "" {
+		sourceSpecified = true
+	}
+	if !sourceSpecified {
+		allErrs = append(allErrs, field.Required(sourcePath, appstudiov1alpha1.MissingGitOrImageSource))
+	}
+
+	return allErrs
+}
+
+// ValidateComponentUpdate validates an update of a Component: in addition to the rules of ValidateComponentCreate, the
+// component name, application and source may not be changed. In particular, the source may not be switched between a
+// git source and an image source; a Component without either may however be given one.
+func ValidateComponentUpdate(newComp, oldComp *appstudiov1alpha1.Component) field.ErrorList {
+	allErrs := ValidateComponentCreate(newComp)
+
+	allErrs = append(allErrs, validateImmutable(specPath.Child("componentName"), newComp.Spec.ComponentName, oldComp.Spec.ComponentName,
+		appstudiov1alpha1.ComponentNameUpdateError)...)
+	allErrs = append(allErrs, validateImmutable(specPath.Child("application"), newComp.Spec.Application, oldComp.Spec.Application,
+		appstudiov1alpha1.ApplicationNameUpdateError)...)
+
+	newSource, oldSource := newComp.Spec.Source, oldComp.Spec.Source
+	if newType, oldType := sourceType(newSource), sourceType(oldSource); oldType != "none" && newType != oldType {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("source"), fmt.Sprintf(appstudiov1alpha1.SourceTypeUpdateError, oldType, newType)))
+	}
+	if newSource.GitSource != nil && oldSource.GitSource != nil && !reflect.DeepEqual(*newSource.GitSource, *oldSource.GitSource) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("source", "git"), fmt.Sprintf(appstudiov1alpha1.GitSourceUpdateError, *newSource.GitSource)))
+	}
+	if newSource.ImageSource != nil && oldSource.ImageSource != nil && !reflect.DeepEqual(*newSource.ImageSource, *oldSource.ImageSource) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("source", "image"), fmt.Sprintf(appstudiov1alpha1.ImageSourceUpdateError, *newSource.ImageSource)))
+	}
+
+	return allErrs
+}
+
+// sourceType returns the name of the member of the given component source which is set: "git", "image", or "none" if
+// neither is.
+func sourceType(source appstudiov1alpha1.ComponentSource) string {
+	switch {
+	case source.GitSource != nil && source.ImageSource != nil:
+		return "git and image"
+	case source.GitSource != nil:
+		return "git"
+	case source.ImageSource != nil:
+		return "image"
+	default:
+		return "none"
+	}
+}
+
+// validateGitSource validates the git source of a Component or ComponentDetectionQuery.
+func validateGitSource(fldPath *field.Path, gitSource *appstudiov1alpha1.GitSource) field.ErrorList {
+	if gitSource.URL == "" {
+		return field.ErrorList{field.Required(fldPath.Child("url"), "")}
+	}
+	return validateURL(fldPath.Child("url"), gitSource.URL, appstudiov1alpha1.InvalidSchemeGitSourceURL, "https", "http")
+}
+
+// validateImageSource validates the image source of a Component.
+func validateImageSource(fldPath *field.Path, imageSource *appstudiov1alpha1.ImageSource) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(fldPath.Child("containerImage"), imageSource.ContainerImage)...)
+	if imageSource.Digest != "" && !imageDigestRegexp.MatchString(imageSource.Digest) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"), imageSource.Digest, validation.RegexError("invalid image digest", imageDigestFmt, imageDigestExample)))
+	}
+
+	return allErrs
+}
+
+// ValidateComponentBuildNudges validates the build nudge references of a new or updated Component against the other
+// Components of its namespace: the Component may not nudge a Component of another Application, nor be part of a nudge
+// cycle. References to Components which do not exist yet are allowed.
+func ValidateComponentBuildNudges(comp *appstudiov1alpha1.Component, componentList *appstudiov1alpha1.ComponentList) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := specPath.Child("build-nudges-ref")
+
+	// Replace any previous version of the Component with the new one, so the graph reflects the Component being admitted
+	components := &appstudiov1alpha1.ComponentList{Items: []appstudiov1alpha1.Component{*comp}}
+	for _, other := range componentList.Items {
+		if other.Name != comp.Name {
+			components.Items = append(components.Items, other)
+		}
+	}
+	graph := nudgegraph.New(components)
+
+	for _, err := range graph.CrossApplicationReferences() {
+		if err.Component == comp.Name {
+			allErrs = append(allErrs, field.Forbidden(fldPath, err.Error()))
+		}
+	}
+	for _, err := range graph.Cycles() {
+		for _, name := range err.Components {
+			if name == comp.Name {
+				allErrs = append(allErrs, field.Forbidden(fldPath, err.Error()))
+				break
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateComponentDetectionQueryCreate validates a new ComponentDetectionQuery.
+func ValidateComponentDetectionQueryCreate(cdq *appstudiov1alpha1.ComponentDetectionQuery) field.ErrorList {
+	return validateGitSource(specPath.Child("git"), &cdq.Spec.GitSource)
+}
+
+// ValidateComponentDetectionQueryUpdate validates an update of a ComponentDetectionQuery.
+func ValidateComponentDetectionQueryUpdate(newCDQ, oldCDQ *appstudiov1alpha1.ComponentDetectionQuery) field.ErrorList {
+	return ValidateComponentDetectionQueryCreate(newCDQ)
+}
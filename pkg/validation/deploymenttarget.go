@@ -0,0 +1,50 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDeploymentTargetCreate validates a new DeploymentTarget.
+func ValidateDeploymentTargetCreate(dt *appstudiov1alpha1.DeploymentTarget) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("deploymentTargetClassName"), string(dt.Spec.DeploymentTargetClassName))...)
+
+	credsPath := specPath.Child("kubernetesCredentials")
+	creds := dt.Spec.KubernetesClusterCredentials
+	if creds.APIURL == "" {
+		allErrs = append(allErrs, field.Required(credsPath.Child("apiURL"), ""))
+	} else {
+		allErrs = append(allErrs, validateURL(credsPath.Child("apiURL"), creds.APIURL, appstudiov1alpha1.InvalidAPIURL, "https")...)
+	}
+	allErrs = append(allErrs, validateRequired(credsPath.Child("clusterCredentialsSecret"), creds.ClusterCredentialsSecret)...)
+
+	return allErrs
+}
+
+// ValidateDeploymentTargetUpdate validates an update of a DeploymentTarget: the DeploymentTargetClass may not be changed.
+func ValidateDeploymentTargetUpdate(newDT, oldDT *appstudiov1alpha1.DeploymentTarget) field.ErrorList {
+	allErrs := ValidateDeploymentTargetCreate(newDT)
+
+	allErrs = append(allErrs, validateImmutable(specPath.Child("deploymentTargetClassName"), string(newDT.Spec.DeploymentTargetClassName),
+		string(oldDT.Spec.DeploymentTargetClassName), appstudiov1alpha1.DeploymentTargetClassNameUpdateError)...)
+
+	return allErrs
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDeploymentTargetClaimCreate validates a new DeploymentTargetClaim.
+func ValidateDeploymentTargetClaimCreate(dtc *appstudiov1alpha1.DeploymentTargetClaim) field.ErrorList {
+	return validateRequired(specPath.Child("deploymentTargetClassName"), string(dtc.Spec.DeploymentTargetClassName))
+}
+
+// ValidateDeploymentTargetClaimUpdate validates an update of a DeploymentTargetClaim: the DeploymentTargetClass may not be
+// changed, and the target name may not be changed once it has been set (either by the user or the binding controller).
+func ValidateDeploymentTargetClaimUpdate(newDTC, oldDTC *appstudiov1alpha1.DeploymentTargetClaim) field.ErrorList {
+	allErrs := ValidateDeploymentTargetClaimCreate(newDTC)
+
+	allErrs = append(allErrs, validateImmutable(specPath.Child("deploymentTargetClassName"), string(newDTC.Spec.DeploymentTargetClassName),
+		string(oldDTC.Spec.DeploymentTargetClassName), appstudiov1alpha1.DeploymentTargetClassNameUpdateError)...)
+	if oldDTC.Spec.TargetName != "" {
+		allErrs = append(allErrs, validateImmutable(specPath.Child("targetName"), newDTC.Spec.TargetName, oldDTC.Spec.TargetName,
+			appstudiov1alpha1.TargetNameUpdateError)...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDeploymentTargetClassCreate validates a new DeploymentTargetClass.
+func ValidateDeploymentTargetClassCreate(dtcls *appstudiov1alpha1.DeploymentTargetClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("provisioner"), string(dtcls.Spec.Provisioner))...)
+
+	switch dtcls.Spec.ReclaimPolicy {
+	case appstudiov1alpha1.ReclaimPolicy_Delete, appstudiov1alpha1.ReclaimPolicy_Retain:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("reclaimPolicy"), dtcls.Spec.ReclaimPolicy,
+			[]string{string(appstudiov1alpha1.ReclaimPolicy_Delete), string(appstudiov1alpha1.ReclaimPolicy_Retain)}))
+	}
+
+	return allErrs
+}
+
+// ValidateDeploymentTargetClassUpdate validates an update of a DeploymentTargetClass.
+func ValidateDeploymentTargetClassUpdate(newDTClass, oldDTClass *appstudiov1alpha1.DeploymentTargetClass) field.ErrorList {
+	return ValidateDeploymentTargetClassCreate(newDTClass)
+}
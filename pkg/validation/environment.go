@@ -0,0 +1,86 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/deploymentschedule"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateEnvironmentCreate validates a new Environment.
+func ValidateEnvironmentCreate(env *appstudiov1alpha1.Environment) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch env.Spec.DeploymentStrategy {
+	case appstudiov1alpha1.DeploymentStrategy_Manual, appstudiov1alpha1.DeploymentStrategy_AppStudioAutomated:
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("deploymentStrategy"), env.Spec.DeploymentStrategy,
+			[]string{string(appstudiov1alpha1.DeploymentStrategy_Manual), string(appstudiov1alpha1.DeploymentStrategy_AppStudioAutomated)}))
+	}
+
+	if env.Spec.ParentEnvironment != "" && env.Spec.ParentEnvironment == env.Name {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("parentEnvironment"), env.Spec.ParentEnvironment, appstudiov1alpha1.ParentEnvironmentSelfReference))
+	}
+
+	if policy := env.Spec.ApprovalPolicy; policy != nil {
+		requiredPath := specPath.Child("approvalPolicy", "requiredApprovals")
+		// an unset number of required approvals defaults to 1
+		if policy.RequiredApprovals < 0 {
+			allErrs = append(allErrs, field.Invalid(requiredPath, policy.RequiredApprovals, appstudiov1alpha1.InvalidRequiredApprovals))
+		} else if len(policy.ApproverGroups) == 0 && len(policy.Approvers) > 0 && policy.RequiredApprovals > len(policy.Approvers) {
+			allErrs = append(allErrs, field.Invalid(requiredPath, policy.RequiredApprovals,
+				fmt.Sprintf(appstudiov1alpha1.TooManyRequiredApprovals, policy.RequiredApprovals, len(policy.Approvers))))
+		}
+	}
+
+	allErrs = append(allErrs, validateRolloutStrategy(specPath.Child("configuration", "rolloutStrategy"), env.Spec.Configuration.RolloutStrategy)...)
+
+	if schedule := env.Spec.DeploymentSchedule; schedule != nil {
+		allErrs = append(allErrs, deploymentschedule.ValidateFields(schedule, specPath.Child("deploymentSchedule"))...)
+	}
+
+	if unstableConfig := env.Spec.UnstableConfigurationFields; unstableConfig != nil {
+		unstablePath := specPath.Child("unstableConfigurationFields")
+
+		// if cluster type is Kubernetes, then Ingress Domain should be set
+		if unstableConfig.ClusterType == appstudiov1alpha1.ConfigurationClusterType_Kubernetes && unstableConfig.IngressDomain == "" {
+			allErrs = append(allErrs, field.Required(unstablePath.Child("kubernetesCredentials", "ingressDomain"), appstudiov1alpha1.MissingIngressDomain))
+		}
+
+		// if Ingress Domain is set, it should be in the format of DNS1123 subdomain
+		if unstableConfig.IngressDomain != "" && len(validation.IsDNS1123Subdomain(unstableConfig.IngressDomain)) != 0 {
+			allErrs = append(allErrs, field.Invalid(unstablePath.Child("kubernetesCredentials", "ingressDomain"), unstableConfig.IngressDomain,
+				fmt.Sprintf(appstudiov1alpha1.InvalidDNS1123Subdomain, unstableConfig.IngressDomain)))
+		}
+
+		// if API URL is set, it should be in the format of URL
+		if unstableConfig.APIURL != "" {
+			allErrs = append(allErrs, validateURL(unstablePath.Child("kubernetesCredentials", "apiURL"), unstableConfig.APIURL, appstudiov1alpha1.InvalidAPIURL, "https")...)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateEnvironmentUpdate validates an update of an Environment.
+func ValidateEnvironmentUpdate(newEnv, oldEnv *appstudiov1alpha1.Environment) field.ErrorList {
+	return ValidateEnvironmentCreate(newEnv)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/promotionrun"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidatePromotionRunCreate validates a new PromotionRun.
+func ValidatePromotionRunCreate(promotionRun *appstudiov1alpha1.PromotionRun) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("application"), promotionRun.Spec.Application)...)
+
+	// For compatibility with PromotionRuns created before the promotions were optional, a promotion without an
+	// environment is considered unset
+	manual, automated, rollback := promotionRun.Spec.ManualPromotion, promotionRun.Spec.AutomatedPromotion, promotionRun.Spec.Rollback
+	manualSet := manual != nil && manual.TargetEnvironment != ""
+	automatedSet := automated != nil && automated.InitialEnvironment != ""
+	set := 0
+	for _, isSet := range []bool{manualSet, automatedSet, rollback != nil} {
+		if isSet {
+			set++
+		}
+	}
+	switch {
+	case set > 1:
+		allErrs = append(allErrs, field.Forbidden(specPath, appstudiov1alpha1.MultiplePromotionTargets))
+	case set == 1:
+		// exactly one promotion is set
+	case manual != nil:
+		allErrs = append(allErrs, field.Required(specPath.Child("manualPromotion", "targetEnvironment"), appstudiov1alpha1.MissingPromotionTarget))
+	case automated != nil:
+		allErrs = append(allErrs, field.Required(specPath.Child("automatedPromotion", "initialEnvironment"), appstudiov1alpha1.MissingPromotionTarget))
+	default:
+		allErrs = append(allErrs, field.Required(specPath, appstudiov1alpha1.MissingPromotionTarget))
+	}
+
+	if rollback != nil {
+		allErrs = append(allErrs, validateRequired(specPath.Child("rollback", "targetEnvironment"), rollback.TargetEnvironment)...)
+		if promotionRun.Spec.Snapshot != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("snapshot"), appstudiov1alpha1.RollbackSnapshotForbidden))
+		}
+	} else {
+		allErrs = append(allErrs, validateRequired(specPath.Child("snapshot"), promotionRun.Spec.Snapshot)...)
+	}
+
+	spec := promotionRun.Spec
+	allErrs = append(allErrs, validatePositiveDuration(specPath.Child("timeout"), spec.Timeout)...)
+	allErrs = append(allErrs, validatePositiveDuration(specPath.Child("environmentTimeout"), spec.EnvironmentTimeout)...)
+	if spec.Timeout != nil && spec.EnvironmentTimeout != nil && spec.EnvironmentTimeout.Duration > spec.Timeout.Duration {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("environmentTimeout"), spec.EnvironmentTimeout.Duration.String(),
+			appstudiov1alpha1.EnvironmentTimeoutTooLong))
+	}
+	if spec.RetryPolicy != nil {
+		retryPath := specPath.Child("retryPolicy")
+		if spec.RetryPolicy.MaxRetries < 0 {
+			allErrs = append(allErrs, field.Invalid(retryPath.Child("maxRetries"), spec.RetryPolicy.MaxRetries, appstudiov1alpha1.InvalidPromotionRunRetries))
+		}
+		allErrs = append(allErrs, validatePositiveDuration(retryPath.Child("backoff"), spec.RetryPolicy.Backoff)...)
+	}
+
+	return allErrs
+}
+
+// ValidatePromotionRunUpdate validates an update of a PromotionRun: the spec may not be changed once the PromotionRun is
+// created, except to request its cancellation, which cannot be withdrawn.
+func ValidatePromotionRunUpdate(newPromotionRun, oldPromotionRun *appstudiov1alpha1.PromotionRun) field.ErrorList {
+	allErrs := ValidatePromotionRunCreate(newPromotionRun)
+
+	if oldPromotionRun.Spec.Cancel && !newPromotionRun.Spec.Cancel {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("cancel"), appstudiov1alpha1.PromotionRunCancelUpdateError))
+	}
+
+	newSpec := newPromotionRun.Spec.DeepCopy()
+	newSpec.Cancel = oldPromotionRun.Spec.Cancel
+	if !reflect.DeepEqual(*newSpec, oldPromotionRun.Spec) {
+		allErrs = append(allErrs, field.Forbidden(specPath, appstudiov1alpha1.PromotionRunSpecUpdateError))
+	}
+
+	return allErrs
+}
+
+// ValidatePromotionRunStatusUpdate validates an update of the status of a PromotionRun, using the rules of the
+// PromotionRun state machine.
+func ValidatePromotionRunStatusUpdate(newPromotionRun, oldPromotionRun *appstudiov1alpha1.PromotionRun) field.ErrorList {
+	return promotionrun.ValidateStatusUpdate(&newPromotionRun.Status, &oldPromotionRun.Status, field.NewPath("status"))
+}
+
+func validatePositiveDuration(fldPath *field.Path, duration *metav1.Duration) field.ErrorList {
+	if duration != nil && duration.Duration <= 0 {
+		return field.ErrorList{field.Invalid(fldPath, duration.Duration.String(), appstudiov1alpha1.InvalidPromotionRunDuration)}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateRolloutStrategy validates a rollout strategy of an Environment or SnapshotEnvironmentBinding component: only
+// the configuration matching the type may be specified, and a canary rollout must consist of valid steps.
+func validateRolloutStrategy(fldPath *field.Path, strategy *appstudiov1alpha1.RolloutStrategy) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strategy == nil {
+		return allErrs
+	}
+
+	switch strategy.Type {
+	case appstudiov1alpha1.RolloutStrategy_Rolling, appstudiov1alpha1.RolloutStrategy_BlueGreen, appstudiov1alpha1.RolloutStrategy_Canary:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), strategy.Type, []string{string(appstudiov1alpha1.RolloutStrategy_Rolling),
+			string(appstudiov1alpha1.RolloutStrategy_BlueGreen), string(appstudiov1alpha1.RolloutStrategy_Canary)}))
+	}
+
+	if strategy.Rolling != nil && strategy.Type != appstudiov1alpha1.RolloutStrategy_Rolling {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rolling"),
+			fmt.Sprintf(appstudiov1alpha1.RolloutStrategyMismatch, "rolling", appstudiov1alpha1.RolloutStrategy_Rolling)))
+	}
+	if strategy.BlueGreen != nil && strategy.Type != appstudiov1alpha1.RolloutStrategy_BlueGreen {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("blueGreen"),
+			fmt.Sprintf(appstudiov1alpha1.RolloutStrategyMismatch, "blueGreen", appstudiov1alpha1.RolloutStrategy_BlueGreen)))
+	}
+
+	canaryPath := fldPath.Child("canary")
+	if strategy.Canary != nil && strategy.Type != appstudiov1alpha1.RolloutStrategy_Canary {
+		allErrs = append(allErrs, field.Forbidden(canaryPath,
+			fmt.Sprintf(appstudiov1alpha1.RolloutStrategyMismatch, "canary", appstudiov1alpha1.RolloutStrategy_Canary)))
+	} else if strategy.Type == appstudiov1alpha1.RolloutStrategy_Canary {
+		if strategy.Canary == nil || len(strategy.Canary.Steps) == 0 {
+			allErrs = append(allErrs, field.Required(canaryPath.Child("steps"), appstudiov1alpha1.MissingCanarySteps))
+		} else {
+			for i, step := range strategy.Canary.Steps {
+				stepPath := canaryPath.Child("steps").Index(i)
+				if (step.SetWeight == nil) == (step.Pause == nil) {
+					allErrs = append(allErrs, field.Forbidden(stepPath, appstudiov1alpha1.InvalidCanaryStep))
+				}
+				if step.SetWeight != nil && (*step.SetWeight < 0 || *step.SetWeight > 100) {
+					allErrs = append(allErrs, field.Invalid(stepPath.Child("setWeight"), *step.SetWeight, appstudiov1alpha1.InvalidCanaryWeight))
+				}
+				if step.Pause != nil && step.Pause.Duration != nil && step.Pause.Duration.Duration <= 0 {
+					allErrs = append(allErrs, field.Invalid(stepPath.Child("pause", "duration"), step.Pause.Duration.Duration.String(),
+						appstudiov1alpha1.InvalidPromotionRunDuration))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
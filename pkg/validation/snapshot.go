@@ -0,0 +1,89 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSnapshotCreate validates a new Snapshot.
+func ValidateSnapshotCreate(snapshot *appstudiov1alpha1.Snapshot) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("application"), snapshot.Spec.Application)...)
+
+	componentsPath := specPath.Child("components")
+	names := map[string]bool{}
+	for i, component := range snapshot.Spec.Components {
+		idxPath := componentsPath.Index(i)
+
+		allErrs = append(allErrs, validateRequired(idxPath.Child("name"), component.Name)...)
+		if names[component.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), component.Name))
+		}
+		names[component.Name] = true
+
+		allErrs = append(allErrs, validateRequired(idxPath.Child("containerImage"), component.ContainerImage)...)
+
+		sourcePath := idxPath.Child("source")
+		if component.Source.GitSource != nil && component.Source.ImageSource != nil {
+			allErrs = append(allErrs, field.Forbidden(sourcePath, appstudiov1alpha1.MultipleGitAndImageSource))
+		}
+		if component.Source.GitSource != nil {
+			allErrs = append(allErrs, validateGitSource(sourcePath.Child("git"), component.Source.GitSource)...)
+		}
+		if component.Source.ImageSource != nil {
+			allErrs = append(allErrs, validateImageSource(sourcePath.Child("image"), component.Source.ImageSource)...)
+		}
+	}
+
+	artifactsPath := specPath.Child("artifacts", "components")
+	artifactNames := map[string]bool{}
+	for i, artifacts := range snapshot.Spec.Artifacts.Components {
+		idxPath := artifactsPath.Index(i)
+
+		if !names[artifacts.Name] {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), artifacts.Name))
+		} else if artifactNames[artifacts.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), artifacts.Name))
+		}
+		artifactNames[artifacts.Name] = true
+
+		if artifacts.Source != nil {
+			allErrs = append(allErrs, validateRequired(idxPath.Child("source", "repositoryURL"), artifacts.Source.RepositoryURL)...)
+			allErrs = append(allErrs, validateRequired(idxPath.Child("source", "commitSHA"), artifacts.Source.CommitSHA)...)
+		}
+		if artifacts.ImageDigest != "" && !imageDigestRegexp.MatchString(artifacts.ImageDigest) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("imageDigest"), artifacts.ImageDigest,
+				validation.RegexError("invalid image digest", imageDigestFmt, imageDigestExample)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateSnapshotUpdate validates an update of a Snapshot: the application may not be changed.
+func ValidateSnapshotUpdate(newSnapshot, oldSnapshot *appstudiov1alpha1.Snapshot) field.ErrorList {
+	allErrs := ValidateSnapshotCreate(newSnapshot)
+
+	allErrs = append(allErrs, validateImmutable(specPath.Child("application"), newSnapshot.Spec.Application, oldSnapshot.Spec.Application,
+		appstudiov1alpha1.ApplicationNameUpdateError)...)
+
+	return allErrs
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+
+	appstudiov1alpha1 "github.com/redhat-appstudio/application-api/api/v1alpha1"
+	"github.com/redhat-appstudio/application-api/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSnapshotEnvironmentBindingCreate validates a new SnapshotEnvironmentBinding.
+func ValidateSnapshotEnvironmentBindingCreate(binding *appstudiov1alpha1.SnapshotEnvironmentBinding) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateRequired(specPath.Child("application"), binding.Spec.Application)...)
+	allErrs = append(allErrs, validateRequired(specPath.Child("environment"), binding.Spec.Environment)...)
+	allErrs = append(allErrs, validateRequired(specPath.Child("snapshot"), binding.Spec.Snapshot)...)
+
+	componentsPath := specPath.Child("components")
+	names := map[string]bool{}
+	for i, component := range binding.Spec.Components {
+		idxPath := componentsPath.Index(i)
+
+		allErrs = append(allErrs, validateRequired(idxPath.Child("name"), component.Name)...)
+		if names[component.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), component.Name))
+		}
+		names[component.Name] = true
+
+		if replicas := component.Configuration.Replicas; replicas != nil && *replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("configuration", "replicas"), *replicas, "must be greater than or equal to 0"))
+		}
+		for j, env := range component.Configuration.Env {
+			allErrs = append(allErrs, validateRequired(idxPath.Child("configuration", "env").Index(j).Child("name"), env.Name)...)
+		}
+		allErrs = append(allErrs, validateRolloutStrategy(idxPath.Child("configuration", "rolloutStrategy"), component.Configuration.RolloutStrategy)...)
+	}
+
+	return allErrs
+}
+
+// ValidateSnapshotEnvironmentBindingUpdate validates an update of a SnapshotEnvironmentBinding: the application and environment
+// may not be changed.
+func ValidateSnapshotEnvironmentBindingUpdate(newBinding, oldBinding *appstudiov1alpha1.SnapshotEnvironmentBinding) field.ErrorList {
+	allErrs := ValidateSnapshotEnvironmentBindingCreate(newBinding)
+
+	allErrs = append(allErrs, validateImmutable(specPath.Child("application"), newBinding.Spec.Application, oldBinding.Spec.Application,
+		appstudiov1alpha1.ApplicationNameUpdateError)...)
+	allErrs = append(allErrs, validateImmutable(specPath.Child("environment"), newBinding.Spec.Environment, oldBinding.Spec.Environment,
+		appstudiov1alpha1.EnvironmentNameUpdateError)...)
+
+	return allErrs
+}
+
+// SnapshotEnvironmentBindingConflict is returned when several SnapshotEnvironmentBindings of a namespace bind the same
+// Application to the same Environment.
+type SnapshotEnvironmentBindingConflict struct {
+	// Namespace is the namespace of the conflicting bindings
+	Namespace string
+
+	// Application is the name of the Application bound by the conflicting bindings
+	Application string
+
+	// Environment is the name of the Environment bound by the conflicting bindings
+	Environment string
+
+	// Bindings are the names of the conflicting bindings, oldest first
+	Bindings []string
+}
+
+func (e *SnapshotEnvironmentBindingConflict) Error() string {
+	return fmt.Sprintf(appstudiov1alpha1.DuplicateSnapshotEnvironmentBindings, e.Application, e.Environment, e.Namespace, e.Bindings)
+}
+
+// ValidateSnapshotEnvironmentBindingUniqueness validates a new or updated SnapshotEnvironmentBinding against the other
+// SnapshotEnvironmentBindings of its namespace: no other binding may bind the same Application to the same Environment.
+// Bindings of other namespaces in bindingList, and the previous version of the binding itself, are ignored.
+func ValidateSnapshotEnvironmentBindingUniqueness(binding *appstudiov1alpha1.SnapshotEnvironmentBinding,
+	bindingList *appstudiov1alpha1.SnapshotEnvironmentBindingList) field.ErrorList {
+
+	allErrs := field.ErrorList{}
+	keys := binding.ApplicationEnvironmentKey()
+	if len(keys) == 0 {
+		// A binding without an application or environment is reported by ValidateSnapshotEnvironmentBindingCreate
+		return allErrs
+	}
+
+	for _, existing := range sortedBindings(bindingList) {
+		if existing.Namespace != binding.Namespace || existing.Name == binding.Name {
+			continue
+		}
+		if existingKeys := existing.ApplicationEnvironmentKey(); len(existingKeys) > 0 && existingKeys[0] == keys[0] {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("environment"),
+				fmt.Sprintf(appstudiov1alpha1.SnapshotEnvironmentBindingExists, existing.Name, binding.Spec.Application, binding.Spec.Environment)))
+		}
+	}
+
+	return allErrs
+}
+
+// FindSnapshotEnvironmentBindingConflicts returns a conflict for each Application and Environment pair that is bound
+// by more than one of the SnapshotEnvironmentBindings in bindingList, in namespace, application and environment order.
+// It is meant to lint existing bindings, for which the admission checks of ValidateSnapshotEnvironmentBindingUniqueness
+// may have been bypassed.
+func FindSnapshotEnvironmentBindingConflicts(bindingList *appstudiov1alpha1.SnapshotEnvironmentBindingList) []*SnapshotEnvironmentBindingConflict {
+	conflicts := map[string]*SnapshotEnvironmentBindingConflict{}
+	for _, binding := range sortedBindings(bindingList) {
+		keys := binding.ApplicationEnvironmentKey()
+		if len(keys) == 0 {
+			continue
+		}
+		key := binding.Namespace + "/" + keys[0]
+		if conflicts[key] == nil {
+			conflicts[key] = &SnapshotEnvironmentBindingConflict{
+				Namespace:   binding.Namespace,
+				Application: binding.Spec.Application,
+				Environment: binding.Spec.Environment,
+			}
+		}
+		conflicts[key].Bindings = append(conflicts[key].Bindings, binding.Name)
+	}
+
+	var errs []*SnapshotEnvironmentBindingConflict
+	for _, conflict := range conflicts {
+		if len(conflict.Bindings) > 1 {
+			errs = append(errs, conflict)
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		if errs[i].Namespace != errs[j].Namespace {
+			return errs[i].Namespace < errs[j].Namespace
+		}
+		if errs[i].Application != errs[j].Application {
+			return errs[i].Application < errs[j].Application
+		}
+		return errs[i].Environment < errs[j].Environment
+	})
+	return errs
+}
+
+// sortedBindings returns the bindings of bindingList from the oldest to the newest, in name order for bindings created
+// at the same time, so that the errors reported for them are stable.
+func sortedBindings(bindingList *appstudiov1alpha1.SnapshotEnvironmentBindingList) []*appstudiov1alpha1.SnapshotEnvironmentBinding {
+	if bindingList == nil {
+		return nil
+	}
+	bindings := make([]*appstudiov1alpha1.SnapshotEnvironmentBinding, 0, len(bindingList.Items))
+	for i := range bindingList.Items {
+		bindings = append(bindings, &bindingList.Items[i])
+	}
+	sort.SliceStable(bindings, func(i, j int) bool {
+		ti, tj := bindings[i].CreationTimestamp, bindings[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return bindings[i].Name < bindings[j].Name
+	})
+	return bindings
+}
+
+// ValidateSnapshotEnvironmentBindingConsistency validates a SnapshotEnvironmentBinding against the Snapshot it
+// references, and against the Components of its namespace:
+//   - the Snapshot must belong to the Application of the binding
+//   - the binding must list each component of the Snapshot, and only the components of the Snapshot
+//   - the binding components must not be Components of another Application
+//
+// The componentList is optional: the Application of the binding components is only checked if it is not nil.
+// Components which are listed in the Snapshot, but no longer exist in the namespace, are allowed.
+func ValidateSnapshotEnvironmentBindingConsistency(binding *appstudiov1alpha1.SnapshotEnvironmentBinding,
+	snapshot *appstudiov1alpha1.Snapshot, componentList *appstudiov1alpha1.ComponentList) field.ErrorList {
+
+	allErrs := field.ErrorList{}
+
+	if snapshot.Spec.Application != binding.Spec.Application {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("snapshot"), binding.Spec.Snapshot,
+			fmt.Sprintf(appstudiov1alpha1.SnapshotApplicationMismatch, snapshot.Name, snapshot.Spec.Application, binding.Spec.Application)))
+	}
+
+	snapshotComponents := map[string]bool{}
+	for _, component := range snapshot.Spec.Components {
+		snapshotComponents[component.Name] = true
+	}
+	componentApplications := map[string]string{}
+	if componentList != nil {
+		for _, component := range componentList.Items {
+			componentApplications[component.Name] = component.Spec.Application
+		}
+	}
+
+	componentsPath := specPath.Child("components")
+	bindingComponents := map[string]bool{}
+	for i, component := range binding.Spec.Components {
+		bindingComponents[component.Name] = true
+		namePath := componentsPath.Index(i).Child("name")
+
+		if !snapshotComponents[component.Name] {
+			allErrs = append(allErrs, field.Invalid(namePath, component.Name,
+				fmt.Sprintf(appstudiov1alpha1.UnknownBindingComponent, component.Name, snapshot.Name)))
+		}
+		if application, exists := componentApplications[component.Name]; exists && application != binding.Spec.Application {
+			allErrs = append(allErrs, field.Invalid(namePath, component.Name,
+				fmt.Sprintf(appstudiov1alpha1.BindingComponentApplicationMismatch, component.Name, application, binding.Spec.Application)))
+		}
+	}
+
+	for _, component := range snapshot.Spec.Components {
+		if !bindingComponents[component.Name] {
+			allErrs = append(allErrs, field.Required(componentsPath,
+				fmt.Sprintf(appstudiov1alpha1.MissingBindingComponent, component.Name, snapshot.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// SetSnapshotEnvironmentBindingConsistencyCondition sets the SnapshotConsistent condition of the binding's
+// BindingConditions from the errors returned by ValidateSnapshotEnvironmentBindingConsistency: the condition is True if
+// there are no errors, and False with each error in its message otherwise. Returns true if the conditions have changed.
+func SetSnapshotEnvironmentBindingConsistencyCondition(binding *appstudiov1alpha1.SnapshotEnvironmentBinding, errs field.ErrorList) bool {
+	condition := metav1.Condition{
+		Type:    appstudiov1alpha1.SnapshotEnvironmentBindingConditionSnapshotConsistent,
+		Status:  metav1.ConditionTrue,
+		Reason:  appstudiov1alpha1.SnapshotEnvironmentBindingReasonConsistent,
+		Message: fmt.Sprintf("the binding is consistent with snapshot %q", binding.Spec.Snapshot),
+	}
+	if len(errs) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = appstudiov1alpha1.SnapshotEnvironmentBindingReasonInconsistent
+		condition.Message = errs.ToAggregate().Error()
+	}
+	return conditions.SetForObject(binding, &binding.Status.BindingConditions, condition)
+}
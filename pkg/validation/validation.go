@@ -0,0 +1,77 @@
+/*
+Copyright 2023 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation contains the validation rules for the appstudio v1alpha1 API types.
+//
+// The rules are the same ones enforced by the admission webhooks of the AppStudio controllers, but have no dependency on
+// controller-runtime, so they can be shared between controllers, CLI tools and tests.
+// Each ValidateXCreate/ValidateXUpdate function returns a field.ErrorList, which is empty if the object is valid.
+package validation
+
+import (
+	"fmt"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	metadataPath = field.NewPath("metadata")
+	specPath     = field.NewPath("spec")
+)
+
+// validateURL returns an error if rawURL is not an absolute URL using one of the given schemes.
+// The msg suffix is appended to the error detail, matching the format of the webhook error messages.
+func validateURL(fldPath *field.Path, rawURL string, msg string, schemes ...string) field.ErrorList {
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, rawURL, err.Error()+msg)}
+	}
+
+	for _, scheme := range schemes {
+		if parsedURL.Scheme == scheme && parsedURL.Host != "" {
+			return nil
+		}
+	}
+	return field.ErrorList{field.Invalid(fldPath, rawURL, fmt.Sprintf("unsupported URL %q", rawURL)+msg)}
+}
+
+// validateDNS1123Label returns an error for each reason value is not a valid DNS-1123 label.
+func validateDNS1123Label(fldPath *field.Path, value string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Label(value) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, msg))
+	}
+	return allErrs
+}
+
+// validateRequired returns an error if value is empty.
+func validateRequired(fldPath *field.Path, value string) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	return nil
+}
+
+// validateImmutable returns an error if the value of a field was changed by an update.
+// The msg format is expected to take the new value as its only argument.
+func validateImmutable(fldPath *field.Path, newValue, oldValue string, msg string) field.ErrorList {
+	if newValue != oldValue {
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf(msg, newValue))}
+	}
+	return nil
+}